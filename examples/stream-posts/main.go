@@ -31,26 +31,25 @@ func run() (err error) {
 		return
 	}
 
-	posts, errs, stop := client.Stream.Posts("AskReddit", reddit.StreamInterval(time.Second*3), reddit.StreamDiscardInitial)
+	// the stream stops after a minute
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	posts, errs, stop := client.Stream.Posts(ctx, "AskReddit", reddit.StreamInterval(time.Second*3), reddit.StreamDiscardInitial)
 	defer stop()
 
-	go func() {
-		for {
-			select {
-			case post, ok := <-posts:
-				if !ok {
-					return
-				}
-				fmt.Printf("Received post: %s\n", post.Title)
-			case err, ok := <-errs:
-				if !ok {
-					return
-				}
-				fmt.Fprintf(os.Stderr, "Error! %v\n", err)
+	for {
+		select {
+		case post, ok := <-posts:
+			if !ok {
+				return
+			}
+			fmt.Printf("Received post: %s\n", post.Title)
+		case err, ok := <-errs:
+			if !ok {
+				return nil
 			}
+			fmt.Fprintf(os.Stderr, "Error! %v\n", err)
 		}
-	}()
-
-	<-time.After(time.Minute)
-	return
+	}
 }
@@ -0,0 +1,416 @@
+// Command genapi generates the service interfaces of the reddit package, and their mocks in the redditmock package.
+//
+// It is meant to be run with go generate from the directory of the reddit package.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	redditImportPath = "github.com/vartanbeno/go-reddit/reddit"
+	interfacesFile   = "api.go"
+	mocksFile        = "redditmock/redditmock.go"
+)
+
+type method struct {
+	name    string
+	doc     *ast.CommentGroup
+	fn      *ast.FuncType
+	imports map[string]string
+}
+
+type service struct {
+	name     string
+	embedded []string
+	methods  []*method
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("genapi: ")
+
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && fi.Name() != interfacesFile
+	}, parser.ParseComments)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	pkg, ok := pkgs["reddit"]
+	if !ok {
+		log.Fatal("reddit package not found in the current directory")
+	}
+
+	services := make(map[string]*service)
+	types := make(map[string]bool)
+
+	get := func(name string) *service {
+		if services[name] == nil {
+			services[name] = &service{name: name}
+		}
+		return services[name]
+	}
+
+	var fileNames []string
+	for name := range pkg.Files {
+		fileNames = append(fileNames, name)
+	}
+	sort.Strings(fileNames)
+
+	for _, fileName := range fileNames {
+		file := pkg.Files[fileName]
+		imports := fileImports(file)
+
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					ts, ok := spec.(*ast.TypeSpec)
+					if !ok {
+						continue
+					}
+					types[ts.Name.Name] = true
+
+					st, ok := ts.Type.(*ast.StructType)
+					if !ok || !strings.HasSuffix(strings.ToLower(ts.Name.Name), "service") {
+						continue
+					}
+					for _, field := range st.Fields.List {
+						if len(field.Names) == 0 {
+							get(ts.Name.Name).embedded = append(get(ts.Name.Name).embedded, typeName(field.Type))
+						}
+					}
+				}
+			case *ast.FuncDecl:
+				if decl.Recv == nil || !decl.Name.IsExported() {
+					continue
+				}
+				recv := typeName(decl.Recv.List[0].Type)
+				if !strings.HasSuffix(strings.ToLower(recv), "service") {
+					continue
+				}
+				get(recv).methods = append(get(recv).methods, &method{
+					name:    decl.Name.Name,
+					doc:     decl.Doc,
+					fn:      decl.Type,
+					imports: imports,
+				})
+			}
+		}
+	}
+
+	var names []string
+	for name := range services {
+		if ast.IsExported(name) && strings.HasSuffix(name, "Service") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var exported []*service
+	for _, name := range names {
+		s := services[name]
+		methods := append([]*method(nil), s.methods...)
+		for _, embedded := range s.embedded {
+			if e, ok := services[embedded]; ok {
+				methods = append(methods, e.methods...)
+			}
+		}
+		sort.Slice(methods, func(i, j int) bool {
+			return methods[i].name < methods[j].name
+		})
+		exported = append(exported, &service{name: name, methods: methods})
+	}
+
+	write(interfacesFile, generateInterfaces(fset, exported))
+	write(mocksFile, generateMocks(fset, exported, types))
+}
+
+func write(path string, src []byte) {
+	formatted, err := format.Source(src)
+	if err != nil {
+		log.Fatalf("%s: %v\n%s", path, err, src)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path, formatted, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func fileImports(file *ast.File) map[string]string {
+	imports := make(map[string]string)
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		name := path[strings.LastIndex(path, "/")+1:]
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		imports[name] = path
+	}
+	return imports
+}
+
+func typeName(expr ast.Expr) string {
+	switch expr := expr.(type) {
+	case *ast.StarExpr:
+		return typeName(expr.X)
+	case *ast.Ident:
+		return expr.Name
+	default:
+		return ""
+	}
+}
+
+func apiName(service string) string {
+	return strings.TrimSuffix(service, "Service") + "API"
+}
+
+const header = "// Code generated by genapi. DO NOT EDIT.\n\n"
+
+func generateInterfaces(fset *token.FileSet, services []*service) []byte {
+	buf := new(bytes.Buffer)
+	imports := make(map[string]bool)
+
+	body := new(bytes.Buffer)
+	for _, s := range services {
+		fmt.Fprintf(body, "// %s is the interface implemented by %s.\n", apiName(s.name), s.name)
+		fmt.Fprintf(body, "// It can be used to substitute the service of a Client, e.g. with a mock in tests.\n")
+		fmt.Fprintf(body, "type %s interface {\n", apiName(s.name))
+		for i, m := range s.methods {
+			if i > 0 {
+				body.WriteString("\n")
+			}
+			if m.doc != nil {
+				for _, c := range m.doc.List {
+					body.WriteString(c.Text + "\n")
+				}
+			}
+			fmt.Fprintf(body, "%s%s\n", m.name, strings.TrimPrefix(node(fset, m.fn), "func"))
+			for _, pkg := range usedPackages(m.fn) {
+				imports[m.imports[pkg]] = true
+			}
+		}
+		fmt.Fprintf(body, "}\n\n")
+	}
+
+	buf.WriteString(header)
+	buf.WriteString("package reddit\n\n")
+	writeImports(buf, imports)
+	body.WriteTo(buf)
+
+	buf.WriteString("var (\n")
+	for _, s := range services {
+		fmt.Fprintf(buf, "\t_ %s = &%s{}\n", apiName(s.name), s.name)
+	}
+	buf.WriteString(")\n")
+
+	return buf.Bytes()
+}
+
+func generateMocks(fset *token.FileSet, services []*service, types map[string]bool) []byte {
+	imports := map[string]bool{redditImportPath: true}
+
+	body := new(bytes.Buffer)
+	for _, s := range services {
+		api := apiName(s.name)
+
+		fmt.Fprintf(body, "// %s is a mock of reddit.%s.\n", api, api)
+		fmt.Fprintf(body, "// Its methods record their calls, and then call the function field of the same name (e.g. GetFunc for Get),\n")
+		fmt.Fprintf(body, "// if set. Otherwise, they return zero values.\n")
+		fmt.Fprintf(body, "type %s struct {\n\tRecorder\n\n", api)
+		for _, m := range s.methods {
+			fn := qualify(m.fn, types).(*ast.FuncType)
+			fmt.Fprintf(body, "\t%sFunc %s\n", m.name, node(fset, fn))
+		}
+		fmt.Fprintf(body, "}\n\n")
+		fmt.Fprintf(body, "var _ reddit.%s = &%s{}\n\n", api, api)
+
+		for _, m := range s.methods {
+			fn := qualify(m.fn, types).(*ast.FuncType)
+			params, args, callArgs := paramList(fset, fn)
+			results := resultList(fset, fn)
+
+			fmt.Fprintf(body, "// %s records the call, and calls %sFunc if it is set.\n", m.name, m.name)
+			fmt.Fprintf(body, "func (m *%s) %s(%s) %s {\n", api, m.name, params, results)
+			fmt.Fprintf(body, "\tm.record(%q%s)\n", m.name, prefixComma(args))
+			fmt.Fprintf(body, "\tif m.%sFunc != nil {\n", m.name)
+			if fn.Results == nil {
+				fmt.Fprintf(body, "\t\tm.%sFunc(%s)\n\t\treturn\n", m.name, callArgs)
+			} else {
+				fmt.Fprintf(body, "\t\treturn m.%sFunc(%s)\n", m.name, callArgs)
+			}
+			fmt.Fprintf(body, "\t}\n")
+			if fn.Results != nil {
+				fmt.Fprintf(body, "\treturn\n")
+			}
+			fmt.Fprintf(body, "}\n\n")
+
+			for _, pkg := range usedPackages(m.fn) {
+				imports[m.imports[pkg]] = true
+			}
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	buf.WriteString(header)
+	buf.WriteString("package redditmock\n\n")
+	writeImports(buf, imports)
+	body.WriteTo(buf)
+
+	return buf.Bytes()
+}
+
+func writeImports(buf *bytes.Buffer, imports map[string]bool) {
+	var paths []string
+	for path := range imports {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	// standard library packages go first, separated from the others
+	isStd := func(path string) bool {
+		return !strings.Contains(strings.Split(path, "/")[0], ".")
+	}
+	sort.SliceStable(paths, func(i, j int) bool {
+		return isStd(paths[i]) && !isStd(paths[j])
+	})
+
+	buf.WriteString("import (\n")
+	for i, path := range paths {
+		if i > 0 && isStd(paths[i-1]) && !isStd(path) {
+			buf.WriteString("\n")
+		}
+		fmt.Fprintf(buf, "\t%q\n", path)
+	}
+	buf.WriteString(")\n\n")
+}
+
+func node(fset *token.FileSet, n ast.Node) string {
+	buf := new(bytes.Buffer)
+	if err := printer.Fprint(buf, fset, n); err != nil {
+		log.Fatal(err)
+	}
+	return buf.String()
+}
+
+// usedPackages returns the names of the packages referenced by the function's signature.
+func usedPackages(fn *ast.FuncType) []string {
+	var pkgs []string
+	ast.Inspect(fn, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if ident, ok := sel.X.(*ast.Ident); ok {
+				pkgs = append(pkgs, ident.Name)
+			}
+			return false
+		}
+		return true
+	})
+	return pkgs
+}
+
+// qualify returns a copy of the expression in which the types of the reddit package are qualified, e.g. reddit.Post.
+func qualify(expr ast.Expr, types map[string]bool) ast.Expr {
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		if types[expr.Name] {
+			return &ast.SelectorExpr{X: ast.NewIdent("reddit"), Sel: ast.NewIdent(expr.Name)}
+		}
+		return ast.NewIdent(expr.Name)
+	case *ast.StarExpr:
+		return &ast.StarExpr{X: qualify(expr.X, types)}
+	case *ast.ArrayType:
+		return &ast.ArrayType{Len: expr.Len, Elt: qualify(expr.Elt, types)}
+	case *ast.MapType:
+		return &ast.MapType{Key: qualify(expr.Key, types), Value: qualify(expr.Value, types)}
+	case *ast.ChanType:
+		return &ast.ChanType{Dir: expr.Dir, Value: qualify(expr.Value, types)}
+	case *ast.Ellipsis:
+		return &ast.Ellipsis{Elt: qualify(expr.Elt, types)}
+	case *ast.FuncType:
+		return &ast.FuncType{
+			Params:  qualifyFields(expr.Params, types),
+			Results: qualifyFields(expr.Results, types),
+		}
+	default:
+		return expr
+	}
+}
+
+func qualifyFields(fields *ast.FieldList, types map[string]bool) *ast.FieldList {
+	if fields == nil {
+		return nil
+	}
+	list := &ast.FieldList{}
+	for _, field := range fields.List {
+		list.List = append(list.List, &ast.Field{Names: field.Names, Type: qualify(field.Type, types)})
+	}
+	return list
+}
+
+// paramList returns the parameters of the function, the arguments to record, and the arguments to forward them.
+func paramList(fset *token.FileSet, fn *ast.FuncType) (string, string, string) {
+	var params, args, callArgs []string
+	i := 0
+	for _, field := range fn.Params.List {
+		names := field.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{ast.NewIdent(fmt.Sprintf("p%d", i))}
+		}
+		for _, name := range names {
+			params = append(params, name.Name+" "+node(fset, field.Type))
+			args = append(args, name.Name)
+			if _, ok := field.Type.(*ast.Ellipsis); ok {
+				callArgs = append(callArgs, name.Name+"...")
+			} else {
+				callArgs = append(callArgs, name.Name)
+			}
+			i++
+		}
+	}
+	return strings.Join(params, ", "), strings.Join(args, ", "), strings.Join(callArgs, ", ")
+}
+
+// resultList returns the results of the function, named so that they can be returned as zero values.
+func resultList(fset *token.FileSet, fn *ast.FuncType) string {
+	if fn.Results == nil {
+		return ""
+	}
+
+	var results []string
+	i := 0
+	for _, field := range fn.Results.List {
+		n := len(field.Names)
+		if n == 0 {
+			n = 1
+		}
+		for j := 0; j < n; j++ {
+			results = append(results, fmt.Sprintf("r%d %s", i, node(fset, field.Type)))
+			i++
+		}
+	}
+	return "(" + strings.Join(results, ", ") + ")"
+}
+
+func prefixComma(s string) string {
+	if s == "" {
+		return ""
+	}
+	return ", " + s
+}
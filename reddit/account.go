@@ -2,8 +2,12 @@ package reddit
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/url"
+	"sort"
+	"strings"
 )
 
 // AccountService handles communication with the account
@@ -222,6 +226,10 @@ type rootRelationshipList struct {
 
 // Info returns some general information about your account.
 func (s *AccountService) Info(ctx context.Context) (*User, *Response, error) {
+	if err := s.client.checkUserContext(); err != nil {
+		return nil, nil, err
+	}
+
 	path := "api/v1/me"
 
 	req, err := s.client.NewRequest(http.MethodGet, path, nil)
@@ -235,11 +243,17 @@ func (s *AccountService) Info(ctx context.Context) (*User, *Response, error) {
 		return nil, resp, err
 	}
 
+	s.client.setRedditID(root.ID)
+
 	return root, resp, nil
 }
 
 // Karma returns a breakdown of your karma per subreddit.
 func (s *AccountService) Karma(ctx context.Context) ([]SubredditKarma, *Response, error) {
+	if err := s.client.checkUserContext(); err != nil {
+		return nil, nil, err
+	}
+
 	path := "api/v1/me/karma"
 
 	req, err := s.client.NewRequest(http.MethodGet, path, nil)
@@ -258,6 +272,10 @@ func (s *AccountService) Karma(ctx context.Context) ([]SubredditKarma, *Response
 
 // Settings returns your account settings.
 func (s *AccountService) Settings(ctx context.Context) (*Settings, *Response, error) {
+	if err := s.client.checkUserContext(); err != nil {
+		return nil, nil, err
+	}
+
 	path := "api/v1/me/prefs"
 
 	req, err := s.client.NewRequest(http.MethodGet, path, nil)
@@ -276,6 +294,10 @@ func (s *AccountService) Settings(ctx context.Context) (*Settings, *Response, er
 
 // UpdateSettings updates your account settings and returns the modified version.
 func (s *AccountService) UpdateSettings(ctx context.Context, settings *Settings) (*Settings, *Response, error) {
+	if err := s.client.checkUserContext(); err != nil {
+		return nil, nil, err
+	}
+
 	path := "api/v1/me/prefs"
 
 	req, err := s.client.NewRequest(http.MethodPatch, path, settings)
@@ -294,6 +316,10 @@ func (s *AccountService) UpdateSettings(ctx context.Context, settings *Settings)
 
 // Trophies returns a list of your trophies.
 func (s *AccountService) Trophies(ctx context.Context) ([]Trophy, *Response, error) {
+	if err := s.client.checkUserContext(); err != nil {
+		return nil, nil, err
+	}
+
 	path := "api/v1/me/trophies"
 
 	req, err := s.client.NewRequest(http.MethodGet, path, nil)
@@ -319,6 +345,10 @@ func (s *AccountService) Trophies(ctx context.Context) ([]Trophy, *Response, err
 
 // Friends returns a list of your friends.
 func (s *AccountService) Friends(ctx context.Context) ([]Relationship, *Response, error) {
+	if err := s.client.checkUserContext(); err != nil {
+		return nil, nil, err
+	}
+
 	path := "prefs/friends"
 
 	req, err := s.client.NewRequest(http.MethodGet, path, nil)
@@ -337,6 +367,10 @@ func (s *AccountService) Friends(ctx context.Context) ([]Relationship, *Response
 
 // Blocked returns a list of your blocked users.
 func (s *AccountService) Blocked(ctx context.Context) ([]Relationship, *Response, error) {
+	if err := s.client.checkUserContext(); err != nil {
+		return nil, nil, err
+	}
+
 	path := "prefs/blocked"
 
 	req, err := s.client.NewRequest(http.MethodGet, path, nil)
@@ -355,6 +389,10 @@ func (s *AccountService) Blocked(ctx context.Context) ([]Relationship, *Response
 
 // Messaging returns blocked users and trusted users, respectively.
 func (s *AccountService) Messaging(ctx context.Context) ([]Relationship, []Relationship, *Response, error) {
+	if err := s.client.checkUserContext(); err != nil {
+		return nil, nil, nil, err
+	}
+
 	path := "prefs/messaging"
 
 	req, err := s.client.NewRequest(http.MethodGet, path, nil)
@@ -376,6 +414,10 @@ func (s *AccountService) Messaging(ctx context.Context) ([]Relationship, []Relat
 
 // Trusted returns a list of your trusted users.
 func (s *AccountService) Trusted(ctx context.Context) ([]Relationship, *Response, error) {
+	if err := s.client.checkUserContext(); err != nil {
+		return nil, nil, err
+	}
+
 	path := "prefs/trusted"
 
 	req, err := s.client.NewRequest(http.MethodGet, path, nil)
@@ -395,6 +437,10 @@ func (s *AccountService) Trusted(ctx context.Context) ([]Relationship, *Response
 // AddTrusted adds a user to your trusted users.
 // This is not visible in the Reddit API docs.
 func (s *AccountService) AddTrusted(ctx context.Context, username string) (*Response, error) {
+	if err := s.client.checkUserContext(); err != nil {
+		return nil, err
+	}
+
 	path := "api/add_whitelisted"
 
 	form := url.Values{}
@@ -413,6 +459,10 @@ func (s *AccountService) AddTrusted(ctx context.Context, username string) (*Resp
 // RemoveTrusted removes a user from your trusted users.
 // This is not visible in the Reddit API docs.
 func (s *AccountService) RemoveTrusted(ctx context.Context, username string) (*Response, error) {
+	if err := s.client.checkUserContext(); err != nil {
+		return nil, err
+	}
+
 	path := "api/remove_whitelisted"
 
 	form := url.Values{}
@@ -426,3 +476,171 @@ func (s *AccountService) RemoveTrusted(ctx context.Context, username string) (*R
 
 	return s.client.Do(ctx, req, nil)
 }
+
+// Scope is a permission that an app can request from a user.
+type Scope struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// Scopes is a set of scope IDs, e.g. "identity", "read", "modposts".
+// The "*" scope grants access to everything.
+type Scopes map[string]struct{}
+
+func newScopes(ids ...string) Scopes {
+	s := make(Scopes, len(ids))
+	for _, id := range ids {
+		s[id] = struct{}{}
+	}
+	return s
+}
+
+// Has determines whether the scope is part of the set.
+func (s Scopes) Has(scope string) bool {
+	if _, ok := s["*"]; ok {
+		return true
+	}
+	_, ok := s[scope]
+	return ok
+}
+
+// Missing returns the scopes that are not part of the set.
+func (s Scopes) Missing(scopes ...string) []string {
+	var missing []string
+	for _, scope := range scopes {
+		if !s.Has(scope) {
+			missing = append(missing, scope)
+		}
+	}
+	return missing
+}
+
+// List returns the scopes of the set, sorted alphabetically.
+func (s Scopes) List() []string {
+	list := make([]string, 0, len(s))
+	for id := range s {
+		list = append(list, id)
+	}
+	sort.Strings(list)
+	return list
+}
+
+// Scopes returns the scopes granted to the client's current access token.
+// If the client doesn't have a token yet, it gets one first.
+// Reddit has no endpoint to look up the scopes of a token, so they are taken from the token itself:
+// if it doesn't have them, ErrUnknownScopes is returned. Tokens obtained by the client always have them.
+func (s *AccountService) Scopes(ctx context.Context) (Scopes, error) {
+	if s.client.oauth2Transport == nil {
+		return nil, errors.New("client is not authenticated")
+	}
+
+	token, err := s.client.oauth2Transport.Source.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	// Reddit separates the scopes with spaces, but commas are accepted when requesting them
+	v, _ := token.Extra("scope").(string)
+	if v == "" {
+		return nil, ErrUnknownScopes
+	}
+	ids := strings.FieldsFunc(v, func(r rune) bool {
+		return r == ' ' || r == ','
+	})
+
+	return newScopes(ids...), nil
+}
+
+// RequireScopes returns an error if any of the scopes weren't granted to the client's current access token.
+// It is useful to fail fast at startup, instead of when first trying to perform an action.
+func (s *AccountService) RequireScopes(ctx context.Context, scopes ...string) error {
+	granted, err := s.Scopes(ctx)
+	if err != nil {
+		return err
+	}
+
+	if missing := granted.Missing(scopes...); len(missing) > 0 {
+		return fmt.Errorf("missing scopes: %s", strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+// ScopeDescriptions returns information about the scopes.
+// If none are provided, it returns information about all existing scopes.
+func (s *AccountService) ScopeDescriptions(ctx context.Context, scopes ...string) (map[string]*Scope, *Response, error) {
+	path := "api/v1/scopes"
+	if len(scopes) > 0 {
+		path += "?scopes=" + url.QueryEscape(strings.Join(scopes, ","))
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	root := make(map[string]*Scope)
+	resp, err := s.client.Do(ctx, req, &root)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return root, resp, nil
+}
+
+// RevokeAccessToken revokes an access token, e.g. when a user logs out of your app.
+func (s *AccountService) RevokeAccessToken(ctx context.Context, token string) (*Response, error) {
+	return s.revokeToken(ctx, token, "access_token")
+}
+
+// RevokeRefreshToken revokes a refresh token, along with all access tokens obtained from it.
+func (s *AccountService) RevokeRefreshToken(ctx context.Context, token string) (*Response, error) {
+	return s.revokeToken(ctx, token, "refresh_token")
+}
+
+func (s *AccountService) revokeToken(ctx context.Context, token, tokenType string) (*Response, error) {
+	if token == "" {
+		return nil, errors.New("token: cannot be empty")
+	}
+
+	// this endpoint lives next to the access token one, not on the API's base URL
+	u, err := s.client.TokenURL.Parse("revoke_token")
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("token", token)
+	form.Set("token_type_hint", tokenType)
+
+	req, err := http.NewRequest(http.MethodPost, u.String(), strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Add(headerContentType, mediaTypeForm)
+	req.SetBasicAuth(url.QueryEscape(s.client.ID), url.QueryEscape(s.client.Secret))
+
+	info := &CallInfo{Operation: callerOperation()}
+	resp, err := s.client.sendRevokeToken(ctx, req, info)
+	return resp, s.client.audit(req, info, resp, err)
+}
+
+func (c *Client) sendRevokeToken(ctx context.Context, req *http.Request, info *CallInfo) (*Response, error) {
+	if c.dryRun != nil {
+		resp, err := c.dryRunResponse(req, info)
+		if err != nil {
+			return nil, err
+		}
+		return newResponse(resp), nil
+	}
+
+	// the request is authenticated with the app's credentials, not the access token
+	resp, err := DoRequestWithClient(ctx, c.tokenHTTPClient(), req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return newResponse(resp), CheckResponse(resp)
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
 )
 
 var expectedInfo = &User{
@@ -139,6 +140,33 @@ func TestAccountService_Info(t *testing.T) {
 	require.Equal(t, expectedInfo, info)
 }
 
+func TestClient_RedditID(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/account/info.json")
+	require.NoError(t, err)
+
+	requests := 0
+	mux.HandleFunc("/api/v1/me", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, blob)
+	})
+
+	for i := 0; i < 2; i++ {
+		id, err := client.RedditID(ctx)
+		require.NoError(t, err)
+		require.Equal(t, expectedInfo.ID, id)
+	}
+	require.Equal(t, 1, requests)
+
+	// the cached ID is used as a full ID where one is needed
+	id, _, err := client.id(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "t2_"+expectedInfo.ID, id)
+	require.Equal(t, 1, requests)
+}
+
 func TestAccountService_Karma(t *testing.T) {
 	client, mux, teardown := setup()
 	defer teardown()
@@ -322,3 +350,159 @@ func TestAccountService_RemoveTrusted(t *testing.T) {
 	_, err := client.Account.RemoveTrusted(ctx, "test123")
 	require.NoError(t, err)
 }
+
+func TestAccountService_Scopes(t *testing.T) {
+	client, _, teardown := setup()
+	defer teardown()
+
+	// the token from the test server has the "*" scope
+	scopes, err := client.Account.Scopes(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"*"}, scopes.List())
+	require.True(t, scopes.Has("modposts"))
+	require.Empty(t, scopes.Missing("identity", "modposts"))
+
+	err = client.Account.RequireScopes(ctx, "identity", "modposts")
+	require.NoError(t, err)
+
+	client, err = NewClient(nil, nil)
+	require.NoError(t, err)
+
+	_, err = client.Account.Scopes(ctx)
+	require.EqualError(t, err, "client is not authenticated")
+}
+
+func TestAccountService_RequireScopes(t *testing.T) {
+	client, _, teardown := setup()
+	defer teardown()
+
+	token := (&oauth2.Token{
+		AccessToken: "token1",
+		TokenType:   "bearer",
+		Expiry:      time.Now().Add(time.Hour),
+	}).WithExtra(map[string]interface{}{"scope": "identity read"})
+
+	store := new(MemoryTokenStore)
+	require.NoError(t, store.SaveToken(token))
+
+	client, err := NewClient(nil,
+		&Credentials{"id1", "secret1", "user1", "password1"},
+		WithBaseURL(client.BaseURL.String()),
+		WithTokenURL(client.TokenURL.String()),
+		WithTokenStore(store),
+	)
+	require.NoError(t, err)
+
+	scopes, err := client.Account.Scopes(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{"identity", "read"}, scopes.List())
+	require.True(t, scopes.Has("read"))
+	require.False(t, scopes.Has("modposts"))
+	require.Equal(t, []string{"modposts", "modlog"}, scopes.Missing("identity", "modposts", "modlog"))
+
+	err = client.Account.RequireScopes(ctx, "identity", "modposts", "modlog")
+	require.EqualError(t, err, "missing scopes: modposts, modlog")
+
+	client, err = NewClient(nil,
+		&Credentials{"id1", "secret1", "user1", "password1"},
+		WithToken(&oauth2.Token{AccessToken: "token1", TokenType: "bearer", Expiry: time.Now().Add(time.Hour)}),
+	)
+	require.NoError(t, err)
+
+	_, err = client.Account.Scopes(ctx)
+	require.Equal(t, ErrUnknownScopes, err)
+
+	err = client.Account.RequireScopes(ctx, "identity")
+	require.Equal(t, ErrUnknownScopes, err)
+}
+
+func TestAccountService_ScopeDescriptions(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/scopes", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "identity,modposts", r.URL.Query().Get("scopes"))
+		fmt.Fprint(w, `{
+			"identity": {
+				"description": "Access my reddit username and signup date.",
+				"id": "identity",
+				"name": "My Identity"
+			},
+			"modposts": {
+				"description": "Approve, remove, mark nsfw, and distinguish content in subreddits I moderate.",
+				"id": "modposts",
+				"name": "Moderate Posts"
+			}
+		}`)
+	})
+
+	scopes, _, err := client.Account.ScopeDescriptions(ctx, "identity", "modposts")
+	require.NoError(t, err)
+	require.Equal(t, map[string]*Scope{
+		"identity": {
+			ID:          "identity",
+			Name:        "My Identity",
+			Description: "Access my reddit username and signup date.",
+		},
+		"modposts": {
+			ID:          "modposts",
+			Name:        "Moderate Posts",
+			Description: "Approve, remove, mark nsfw, and distinguish content in subreddits I moderate.",
+		},
+	}, scopes)
+}
+
+func TestAccountService_RevokeAccessToken(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/revoke_token", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Contains(t, r.Header.Get(headerUserAgent), libraryName)
+
+		id, secret, ok := r.BasicAuth()
+		require.True(t, ok)
+		require.Equal(t, "id1", id)
+		require.Equal(t, "secret1", secret)
+
+		form := url.Values{}
+		form.Set("token", "token1")
+		form.Set("token_type_hint", "access_token")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := client.Account.RevokeAccessToken(ctx, "token1")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	_, err = client.Account.RevokeAccessToken(ctx, "")
+	require.EqualError(t, err, "token: cannot be empty")
+}
+
+func TestAccountService_RevokeRefreshToken(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/revoke_token", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		form := url.Values{}
+		form.Set("token", "refresh1")
+		form.Set("token_type_hint", "refresh_token")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.PostForm)
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Account.RevokeRefreshToken(ctx, "refresh1")
+	require.NoError(t, err)
+}
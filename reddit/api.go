@@ -0,0 +1,862 @@
+// Code generated by genapi. DO NOT EDIT.
+
+package reddit
+
+import (
+	"context"
+)
+
+// AccountAPI is the interface implemented by AccountService.
+// It can be used to substitute the service of a Client, e.g. with a mock in tests.
+type AccountAPI interface {
+	// AddTrusted adds a user to your trusted users.
+	// This is not visible in the Reddit API docs.
+	AddTrusted(ctx context.Context, username string) (*Response, error)
+
+	// Blocked returns a list of your blocked users.
+	Blocked(ctx context.Context) ([]Relationship, *Response, error)
+
+	// Friends returns a list of your friends.
+	Friends(ctx context.Context) ([]Relationship, *Response, error)
+
+	// Info returns some general information about your account.
+	Info(ctx context.Context) (*User, *Response, error)
+
+	// Karma returns a breakdown of your karma per subreddit.
+	Karma(ctx context.Context) ([]SubredditKarma, *Response, error)
+
+	// Messaging returns blocked users and trusted users, respectively.
+	Messaging(ctx context.Context) ([]Relationship, []Relationship, *Response, error)
+
+	// RemoveTrusted removes a user from your trusted users.
+	// This is not visible in the Reddit API docs.
+	RemoveTrusted(ctx context.Context, username string) (*Response, error)
+
+	// RequireScopes returns an error if any of the scopes weren't granted to the client's current access token.
+	// It is useful to fail fast at startup, instead of when first trying to perform an action.
+	RequireScopes(ctx context.Context, scopes ...string) error
+
+	// RevokeAccessToken revokes an access token, e.g. when a user logs out of your app.
+	RevokeAccessToken(ctx context.Context, token string) (*Response, error)
+
+	// RevokeRefreshToken revokes a refresh token, along with all access tokens obtained from it.
+	RevokeRefreshToken(ctx context.Context, token string) (*Response, error)
+
+	// ScopeDescriptions returns information about the scopes.
+	// If none are provided, it returns information about all existing scopes.
+	ScopeDescriptions(ctx context.Context, scopes ...string) (map[string]*Scope, *Response, error)
+
+	// Scopes returns the scopes granted to the client's current access token.
+	// If the client doesn't have a token yet, it gets one first.
+	// Reddit has no endpoint to look up the scopes of a token, so they are taken from the token itself:
+	// if it doesn't have them, ErrUnknownScopes is returned. Tokens obtained by the client always have them.
+	Scopes(ctx context.Context) (Scopes, error)
+
+	// Settings returns your account settings.
+	Settings(ctx context.Context) (*Settings, *Response, error)
+
+	// Trophies returns a list of your trophies.
+	Trophies(ctx context.Context) ([]Trophy, *Response, error)
+
+	// Trusted returns a list of your trusted users.
+	Trusted(ctx context.Context) ([]Relationship, *Response, error)
+
+	// UpdateSettings updates your account settings and returns the modified version.
+	UpdateSettings(ctx context.Context, settings *Settings) (*Settings, *Response, error)
+}
+
+// CollectionAPI is the interface implemented by CollectionService.
+// It can be used to substitute the service of a Client, e.g. with a mock in tests.
+type CollectionAPI interface {
+	// AddPost adds a post (via its full ID) to a collection (via its id).
+	AddPost(ctx context.Context, postID, collectionID string) (*Response, error)
+
+	// Create creates a collection.
+	Create(ctx context.Context, createRequest *CollectionCreateRequest) (*Collection, *Response, error)
+
+	// Delete deletes a collection via its id.
+	Delete(ctx context.Context, id string) (*Response, error)
+
+	// Follow follows a collection.
+	Follow(ctx context.Context, id string) (*Response, error)
+
+	// FromSubreddit gets all collections in the subreddit.
+	FromSubreddit(ctx context.Context, id string) ([]*Collection, *Response, error)
+
+	// Get gets a collection by its ID.
+	Get(ctx context.Context, id string) (*Collection, *Response, error)
+
+	// RemovePost removes a post (via its full ID) from a collection (via its id).
+	RemovePost(ctx context.Context, postID, collectionID string) (*Response, error)
+
+	// ReorderPosts reorders posts in a collection.
+	ReorderPosts(ctx context.Context, collectionID string, postIDs ...string) (*Response, error)
+
+	// Unfollow unfollows a collection.
+	Unfollow(ctx context.Context, id string) (*Response, error)
+
+	// UpdateDescription updates a collection's description.
+	UpdateDescription(ctx context.Context, id string, description string) (*Response, error)
+
+	// UpdateLayoutGallery updates a collection's layout to the gallery format.
+	UpdateLayoutGallery(ctx context.Context, id string) (*Response, error)
+
+	// UpdateLayoutTimeline updates a collection's layout to the timeline format.
+	UpdateLayoutTimeline(ctx context.Context, id string) (*Response, error)
+
+	// UpdateTitle updates a collection's title.
+	UpdateTitle(ctx context.Context, id string, title string) (*Response, error)
+}
+
+// CommentAPI is the interface implemented by CommentService.
+// It can be used to substitute the service of a Client, e.g. with a mock in tests.
+type CommentAPI interface {
+	// Delete deletes a post or comment via its full ID.
+	Delete(ctx context.Context, id string) (*Response, error)
+
+	// DisableReplies dsables inbox replies for one of your posts or comments.
+	DisableReplies(ctx context.Context, id string) (*Response, error)
+
+	// Downvote downvotes a post or a comment.
+	Downvote(ctx context.Context, id string) (*Response, error)
+
+	// Edit edits a comment.
+	Edit(ctx context.Context, id string, text string) (*Comment, *Response, error)
+
+	// EnableReplies enables inbox replies for one of your posts or comments.
+	EnableReplies(ctx context.Context, id string) (*Response, error)
+
+	// LoadMoreReplies retrieves more replies that were left out when initially fetching the comment.
+	LoadMoreReplies(ctx context.Context, comment *Comment) (*Response, error)
+
+	// Lock locks a post or comment, preventing it from receiving new comments.
+	Lock(ctx context.Context, id string) (*Response, error)
+
+	// RemoveVote removes your vote on a post or a comment.
+	RemoveVote(ctx context.Context, id string) (*Response, error)
+
+	// Report reports a post or comment.
+	// The reason must not be longer than 100 characters.
+	Report(ctx context.Context, id string, reason string) (*Response, error)
+
+	// Save saves a post or comment.
+	Save(ctx context.Context, id string) (*Response, error)
+
+	// Submit submits a comment as a reply to a post, comment, or message.
+	// parentID is the full ID of the thing being replied to.
+	Submit(ctx context.Context, parentID string, text string) (*Comment, *Response, error)
+
+	// Unlock unlocks a post or comment, allowing it to receive new comments.
+	Unlock(ctx context.Context, id string) (*Response, error)
+
+	// Unsave unsaves a post or comment.
+	Unsave(ctx context.Context, id string) (*Response, error)
+
+	// Upvote upvotes a post or a comment.
+	Upvote(ctx context.Context, id string) (*Response, error)
+}
+
+// EmojiAPI is the interface implemented by EmojiService.
+// It can be used to substitute the service of a Client, e.g. with a mock in tests.
+type EmojiAPI interface {
+	// Delete deletes the emoji from the subreddit.
+	Delete(ctx context.Context, subreddit string, emoji string) (*Response, error)
+
+	// DisableCustomSize disables the custom emoji size in the subreddit.
+	DisableCustomSize(ctx context.Context, subreddit string) (*Response, error)
+
+	// Get returns the default set of Reddit emojis, and those of the subreddit, respectively.
+	Get(ctx context.Context, subreddit string) ([]*Emoji, []*Emoji, *Response, error)
+
+	// SetSize sets the custom emoji size in the subreddit.
+	// Both height and width must be between 1 and 40 (inclusive).
+	SetSize(ctx context.Context, subreddit string, height, width int) (*Response, error)
+
+	// Update updates an emoji on the subreddit.
+	Update(ctx context.Context, subreddit string, updateRequest *EmojiCreateOrUpdateRequest) (*Response, error)
+
+	// Upload uploads an emoji to the subreddit.
+	Upload(ctx context.Context, subreddit string, createRequest *EmojiCreateOrUpdateRequest, imagePath string) (*Response, error)
+}
+
+// FlairAPI is the interface implemented by FlairService.
+// It can be used to substitute the service of a Client, e.g. with a mock in tests.
+type FlairAPI interface {
+	// GetPostFlairs returns the post flairs from the subreddit.
+	GetPostFlairs(ctx context.Context, subreddit string) ([]*Flair, *Response, error)
+
+	// GetUserFlairs returns the user flairs from the subreddit.
+	GetUserFlairs(ctx context.Context, subreddit string) ([]*Flair, *Response, error)
+
+	// ListUserFlairs returns all flairs of individual users in the subreddit.
+	ListUserFlairs(ctx context.Context, subreddit string) ([]*FlairSummary, *Response, error)
+}
+
+// GoldAPI is the interface implemented by GoldService.
+// It can be used to substitute the service of a Client, e.g. with a mock in tests.
+type GoldAPI interface {
+	// Gild the post or comment via its full ID.
+	// This requires you to own Reddit coins and will consume them.
+	Gild(ctx context.Context, id string) (*Response, error)
+
+	// Give the user between 1 and 36 (inclusive) months of gold.
+	// This requires you to own Reddit coins and will consume them.
+	Give(ctx context.Context, username string, months int) (*Response, error)
+}
+
+// ListingsAPI is the interface implemented by ListingsService.
+// It can be used to substitute the service of a Client, e.g. with a mock in tests.
+type ListingsAPI interface {
+	// Get returns posts, comments, and subreddits from their full IDs.
+	// Reddit only returns up to 100 things per request, so if more IDs are provided, they are split
+	// into batches of 100 which are fetched concurrently. The things are returned in the order of
+	// their IDs. If some of the batches fail, the things from the other ones are returned along
+	// with a *BatchError.
+	Get(ctx context.Context, ids ...string) ([]*Post, []*Comment, []*Subreddit, *Response, error)
+
+	// GetPosts returns posts from their full IDs.
+	// Reddit only returns up to 100 posts per request, so if more IDs are provided, they are split
+	// into batches of 100 which are fetched concurrently. The posts are returned in the order of
+	// their IDs. If some of the batches fail, the posts from the other ones are returned along
+	// with a *BatchError.
+	GetPosts(ctx context.Context, ids ...string) ([]*Post, *Response, error)
+
+	// GetPostsByURL returns the posts that link to the URL, across all of Reddit.
+	// Use the after/before anchors of the result to get subsequent pages.
+	GetPostsByURL(ctx context.Context, link string, opts *ListOptions) (*Posts, *Response, error)
+
+	// GetSubredditsByName returns subreddits from their names.
+	// The subreddits are returned in the order of their names.
+	GetSubredditsByName(ctx context.Context, names ...string) ([]*Subreddit, *Response, error)
+}
+
+// MessageAPI is the interface implemented by MessageService.
+// It can be used to substitute the service of a Client, e.g. with a mock in tests.
+type MessageAPI interface {
+	// Block blocks the author of a thing via the thing's full ID.
+	// The thing can be a post, comment or message.
+	Block(ctx context.Context, id string) (*Response, error)
+
+	// Collapse collapses messages.
+	Collapse(ctx context.Context, ids ...string) (*Response, error)
+
+	// Delete deletes a message.
+	Delete(ctx context.Context, id string) (*Response, error)
+
+	// Inbox returns comments and messages that appear in your inbox, respectively.
+	Inbox(ctx context.Context, opts *ListOptions) (*Messages, *Messages, *Response, error)
+
+	// InboxUnread returns unread comments and messages that appear in your inbox, respectively.
+	InboxUnread(ctx context.Context, opts *ListOptions) (*Messages, *Messages, *Response, error)
+
+	// Read marks a message/comment as read via its full ID.
+	Read(ctx context.Context, ids ...string) (*Response, error)
+
+	// ReadAll marks all messages/comments as read. It queues up the task on Reddit's end.
+	// A successful response returns 202 to acknowledge acceptance of the request.
+	// This endpoint is heavily rate limited.
+	ReadAll(ctx context.Context) (*Response, error)
+
+	// Send sends a message.
+	Send(ctx context.Context, sendRequest *SendMessageRequest) (*Response, error)
+
+	// Sent returns messages that you've sent.
+	Sent(ctx context.Context, opts *ListOptions) (*Messages, *Response, error)
+
+	// Uncollapse uncollapses messages.
+	Uncollapse(ctx context.Context, ids ...string) (*Response, error)
+
+	// Unread marks a message/comment as unread via its full ID.
+	Unread(ctx context.Context, ids ...string) (*Response, error)
+}
+
+// ModerationAPI is the interface implemented by ModerationService.
+// It can be used to substitute the service of a Client, e.g. with a mock in tests.
+type ModerationAPI interface {
+	// AcceptInvite accepts a pending invite to moderate the specified subreddit.
+	AcceptInvite(ctx context.Context, subreddit string) (*Response, error)
+
+	// Approve approves a post or comment via its full ID.
+	Approve(ctx context.Context, id string) (*Response, error)
+
+	// ApproveUser adds a user as an approved user to the subreddit.
+	ApproveUser(ctx context.Context, subreddit string, username string) (*Response, error)
+
+	// ApproveUserWiki adds a user as an approved wiki contributor in the subreddit.
+	ApproveUserWiki(ctx context.Context, subreddit string, username string) (*Response, error)
+
+	// Ban a user from the subreddit.
+	Ban(ctx context.Context, subreddit string, username string, config *BanConfig) (*Response, error)
+
+	// BanWiki a user from contributing to the subreddit wiki.
+	BanWiki(ctx context.Context, subreddit string, username string, config *BanConfig) (*Response, error)
+
+	// Edited gets posts and comments that have been edited recently.
+	Edited(ctx context.Context, subreddit string, opts *ListOptions) (*Posts, *Comments, *Response, error)
+
+	// GetActions gets a list of moderator actions on a subreddit.
+	GetActions(ctx context.Context, subreddit string, opts *ListModActionOptions) (*ModActions, *Response, error)
+
+	// IgnoreReports prevents reports on a post or comment from causing notifications.
+	IgnoreReports(ctx context.Context, id string) (*Response, error)
+
+	// Invite a user to become a moderator of the subreddit.
+	// If permissions is nil, all permissions will be granted.
+	Invite(ctx context.Context, subreddit string, username string, permissions *ModPermissions) (*Response, error)
+
+	// Leave abdicates your moderator status in a subreddit via its full ID.
+	Leave(ctx context.Context, subredditID string) (*Response, error)
+
+	// LeaveContributor abdicates your approved user status in a subreddit via its full ID.
+	LeaveContributor(ctx context.Context, subredditID string) (*Response, error)
+
+	// Mute a user in the subreddit.
+	Mute(ctx context.Context, subreddit string, username string) (*Response, error)
+
+	// Remove removes a post, comment or modmail message via its full ID.
+	Remove(ctx context.Context, id string) (*Response, error)
+
+	// RemoveSpam removes a post, comment or modmail message via its full ID and marks it as spam.
+	RemoveSpam(ctx context.Context, id string) (*Response, error)
+
+	// SetPermissions sets the mod permissions for the user in the subreddit.
+	// If permissions is nil, all permissions will be granted.
+	SetPermissions(ctx context.Context, subreddit string, username string, permissions *ModPermissions) (*Response, error)
+
+	// UnapproveUser removes a user as an approved user to the subreddit.
+	UnapproveUser(ctx context.Context, subreddit string, username string) (*Response, error)
+
+	// UnapproveUserWiki removes a user as an approved wiki contributor in the subreddit.
+	UnapproveUserWiki(ctx context.Context, subreddit string, username string) (*Response, error)
+
+	// Unban a user from the subreddit.
+	Unban(ctx context.Context, subreddit string, username string) (*Response, error)
+
+	// UnbanWiki a user from contributing to the subreddit wiki.
+	UnbanWiki(ctx context.Context, subreddit string, username string) (*Response, error)
+
+	// UnignoreReports allows reports on a post or comment to cause notifications.
+	UnignoreReports(ctx context.Context, id string) (*Response, error)
+
+	// Uninvite a user from becoming a moderator of the subreddit.
+	Uninvite(ctx context.Context, subreddit string, username string) (*Response, error)
+
+	// Unmute a user in the subreddit.
+	Unmute(ctx context.Context, subreddit string, username string) (*Response, error)
+}
+
+// MultiAPI is the interface implemented by MultiService.
+// It can be used to substitute the service of a Client, e.g. with a mock in tests.
+type MultiAPI interface {
+	// AddSubreddit adds a subreddit to a multireddit.
+	AddSubreddit(ctx context.Context, multiPath string, subreddit string) (*Response, error)
+
+	// Copy copies a multireddit.
+	Copy(ctx context.Context, copyRequest *MultiCopyRequest) (*Multi, *Response, error)
+
+	// Create creates a multireddit.
+	Create(ctx context.Context, createRequest *MultiCreateOrUpdateRequest) (*Multi, *Response, error)
+
+	// Delete deletes a multireddit.
+	Delete(ctx context.Context, multiPath string) (*Response, error)
+
+	// DeleteSubreddit removes a subreddit from a multireddit.
+	DeleteSubreddit(ctx context.Context, multiPath string, subreddit string) (*Response, error)
+
+	// Get gets information about the multireddit from its url path.
+	Get(ctx context.Context, multiPath string) (*Multi, *Response, error)
+
+	// GetDescription gets a multireddit's description.
+	GetDescription(ctx context.Context, multiPath string) (string, *Response, error)
+
+	// Mine returns your multireddits.
+	Mine(ctx context.Context) ([]Multi, *Response, error)
+
+	// Of returns the user's public multireddits.
+	// Or, if the user is you, all of your multireddits.
+	Of(ctx context.Context, username string) ([]Multi, *Response, error)
+
+	// Update updates a multireddit.
+	// If the multireddit does not exist, it will be created.
+	Update(ctx context.Context, multiPath string, updateRequest *MultiCreateOrUpdateRequest) (*Multi, *Response, error)
+
+	// UpdateDescription updates a multireddit's description.
+	UpdateDescription(ctx context.Context, multiPath string, description string) (string, *Response, error)
+}
+
+// PostAPI is the interface implemented by PostService.
+// It can be used to substitute the service of a Client, e.g. with a mock in tests.
+type PostAPI interface {
+	// ClearSuggestedSort clears the suggested comment sort for the post.
+	ClearSuggestedSort(ctx context.Context, id string) (*Response, error)
+
+	// Delete deletes a post or comment via its full ID.
+	Delete(ctx context.Context, id string) (*Response, error)
+
+	// DisableContestMode disables contest mode for the post.
+	DisableContestMode(ctx context.Context, id string) (*Response, error)
+
+	// DisableReplies dsables inbox replies for one of your posts or comments.
+	DisableReplies(ctx context.Context, id string) (*Response, error)
+
+	// Downvote downvotes a post or a comment.
+	Downvote(ctx context.Context, id string) (*Response, error)
+
+	// Duplicates returns the post with the id, and a list of its duplicates.
+	// id is the ID36 of the post, not its full id.
+	// Example: instead of t3_abc123, use abc123.
+	Duplicates(ctx context.Context, id string, opts *ListDuplicatePostOptions) (*Post, *Posts, *Response, error)
+
+	// Edit edits a post.
+	Edit(ctx context.Context, id string, text string) (*Post, *Response, error)
+
+	// EnableContestMode enables contest mode for the post.
+	// Comments will be sorted randomly and regular users cannot see comment scores.
+	EnableContestMode(ctx context.Context, id string) (*Response, error)
+
+	// EnableReplies enables inbox replies for one of your posts or comments.
+	EnableReplies(ctx context.Context, id string) (*Response, error)
+
+	// Get returns a post with its comments.
+	// id is the ID36 of the post, not its full id.
+	// Example: instead of t3_abc123, use abc123.
+	Get(ctx context.Context, id string) (*PostAndComments, *Response, error)
+
+	// Hide hides posts.
+	Hide(ctx context.Context, ids ...string) (*Response, error)
+
+	// LoadMoreComments retrieves more comments that were left out when initially fetching the post.
+	LoadMoreComments(ctx context.Context, pc *PostAndComments) (*Response, error)
+
+	// Lock locks a post or comment, preventing it from receiving new comments.
+	Lock(ctx context.Context, id string) (*Response, error)
+
+	// MarkNSFW marks a post as NSFW.
+	MarkNSFW(ctx context.Context, id string) (*Response, error)
+
+	// MarkVisited marks the post(s) as visited.
+	// This method requires a subscription to Reddit premium.
+	MarkVisited(ctx context.Context, ids ...string) (*Response, error)
+
+	// PinToProfile pins one of your posts to your profile.
+	// TODO: very inconsistent behaviour, not sure I'm ready to include this parameter yet.
+	// The pos parameter should be a number between 1-4 (inclusive), indicating the position at which
+	// the post should appear on your profile.
+	// Note: The position will be bumped upward if there's space. E.g. if you only have 1 pinned post,
+	// and you try to pin another post to position 3, it will be pinned at 2.
+	// When attempting to pin a post that's already pinned, it will return a 409 Conflict error.
+	PinToProfile(ctx context.Context, id string) (*Response, error)
+
+	// Random returns a random post and its comments from all of Reddit.
+	Random(ctx context.Context) (*PostAndComments, *Response, error)
+
+	// RandomFromSubreddits returns a random post and its comments from the subreddits.
+	// If no subreddits are provided, Reddit runs the query against your subscriptions.
+	RandomFromSubreddits(ctx context.Context, subreddits ...string) (*PostAndComments, *Response, error)
+
+	// RandomFromSubscriptions returns a random post and its comments from your subscriptions.
+	RandomFromSubscriptions(ctx context.Context) (*PostAndComments, *Response, error)
+
+	// RemoveVote removes your vote on a post or a comment.
+	RemoveVote(ctx context.Context, id string) (*Response, error)
+
+	// Report reports a post or comment.
+	// The reason must not be longer than 100 characters.
+	Report(ctx context.Context, id string, reason string) (*Response, error)
+
+	// Save saves a post or comment.
+	Save(ctx context.Context, id string) (*Response, error)
+
+	// SetSuggestedSortAMA sets the suggested comment sort for the post to a Q&A styled fashion.
+	SetSuggestedSortAMA(ctx context.Context, id string) (*Response, error)
+
+	// SetSuggestedSortBest sets the suggested comment sort for the post to best.
+	SetSuggestedSortBest(ctx context.Context, id string) (*Response, error)
+
+	// SetSuggestedSortControversial sets the suggested comment sort for the post to controversial.
+	SetSuggestedSortControversial(ctx context.Context, id string) (*Response, error)
+
+	// SetSuggestedSortLive sets the suggested comment sort for the post to stream new comments as they're posted.
+	// As of now, this is still in beta, so it's not a fully developed feature yet. It just sets the sort as "new" for now.
+	SetSuggestedSortLive(ctx context.Context, id string) (*Response, error)
+
+	// SetSuggestedSortNew sets the suggested comment sort for the post to new.
+	SetSuggestedSortNew(ctx context.Context, id string) (*Response, error)
+
+	// SetSuggestedSortOld sorts the comments on the posts randomly.
+	SetSuggestedSortOld(ctx context.Context, id string) (*Response, error)
+
+	// SetSuggestedSortRandom sets the suggested comment sort for the post to random.
+	SetSuggestedSortRandom(ctx context.Context, id string) (*Response, error)
+
+	// SetSuggestedSortTop sets the suggested comment sort for the post to top.
+	SetSuggestedSortTop(ctx context.Context, id string) (*Response, error)
+
+	// Spoiler marks a post as a spoiler.
+	Spoiler(ctx context.Context, id string) (*Response, error)
+
+	// Sticky stickies a post in its subreddit.
+	// When bottom is true, the post will be set as the bottom sticky (the 2nd one).
+	// If no top sticky exists, the post will become the top sticky regardless.
+	// When attempting to sticky a post that's already stickied, it will return a 409 Conflict error.
+	Sticky(ctx context.Context, id string, bottom bool) (*Response, error)
+
+	// SubmitLink submits a link post.
+	SubmitLink(ctx context.Context, opts SubmitLinkOptions) (*Submitted, *Response, error)
+
+	// SubmitText submits a text post.
+	SubmitText(ctx context.Context, opts SubmitTextOptions) (*Submitted, *Response, error)
+
+	// Unhide unhides posts.
+	Unhide(ctx context.Context, ids ...string) (*Response, error)
+
+	// Unlock unlocks a post or comment, allowing it to receive new comments.
+	Unlock(ctx context.Context, id string) (*Response, error)
+
+	// UnmarkNSFW unmarks a post as NSFW.
+	UnmarkNSFW(ctx context.Context, id string) (*Response, error)
+
+	// UnpinFromProfile unpins one of your posts from your profile.
+	UnpinFromProfile(ctx context.Context, id string) (*Response, error)
+
+	// Unsave unsaves a post or comment.
+	Unsave(ctx context.Context, id string) (*Response, error)
+
+	// Unspoiler unmarks a post as a spoiler.
+	Unspoiler(ctx context.Context, id string) (*Response, error)
+
+	// Unsticky unstickies a post in its subreddit.
+	Unsticky(ctx context.Context, id string) (*Response, error)
+
+	// Upvote upvotes a post or a comment.
+	Upvote(ctx context.Context, id string) (*Response, error)
+}
+
+// StreamAPI is the interface implemented by StreamService.
+// It can be used to substitute the service of a Client, e.g. with a mock in tests.
+type StreamAPI interface {
+	// CommentReplies streams the replies to your comments.
+	// See Inbox for more information.
+	CommentReplies(ctx context.Context, opts ...StreamOpt) (<-chan *Message, <-chan error, func())
+
+	// Comments streams comments from the specified subreddit.
+	// To stream from multiple, separate the names with a plus (+), e.g. "golang+test".
+	// To stream from all, just specify "all".
+	// It returns 2 channels and a function:
+	//   - a channel into which new comments will be sent
+	//   - a channel into which any errors will be sent
+	//   - a function that the client can call to stop the streaming and close the channels
+	// The streaming also stops when the context is done, including any request in flight.
+	// Because of the 100 comment limit imposed by Reddit when fetching comments, some high-traffic
+	// streams might drop comments between API requests, such as when streaming r/all.
+	Comments(ctx context.Context, subreddit string, opts ...StreamOpt) (<-chan *Comment, <-chan error, func())
+
+	// Edited streams the posts and comments of the specified subreddit as they get edited.
+	// Items that are edited again after they've been streamed aren't streamed a second time,
+	// as long as they're remembered (see StreamDedupCapacity). See ModQueue for more information.
+	Edited(ctx context.Context, subreddit string, opts ...StreamOpt) (<-chan *ModerationItem, <-chan error, func())
+
+	// Inbox streams the comments and messages that appear in your inbox.
+	// It returns 2 channels and a function:
+	//   - a channel into which new comments and messages will be sent
+	//   - a channel into which any errors will be sent
+	//   - a function that the client can call to stop the streaming and close the channels
+	// The streaming also stops when the context is done, including any request in flight.
+	// With the StreamMarkRead option, they are marked as read once they've been received.
+	Inbox(ctx context.Context, opts ...StreamOpt) (<-chan *Message, <-chan error, func())
+
+	// InboxUnread streams the unread comments and messages that appear in your inbox.
+	// See Inbox for more information.
+	InboxUnread(ctx context.Context, opts ...StreamOpt) (<-chan *Message, <-chan error, func())
+
+	// Mentions streams the comments that mention your username.
+	// See Inbox for more information.
+	Mentions(ctx context.Context, opts ...StreamOpt) (<-chan *Message, <-chan error, func())
+
+	// Messages streams the private messages that you receive.
+	// See Inbox for more information.
+	Messages(ctx context.Context, opts ...StreamOpt) (<-chan *Message, <-chan error, func())
+
+	// ModActions streams the actions performed by the moderators of the specified subreddit,
+	// from its moderation log. To stream from all the subreddits you moderate, specify "mod".
+	// It returns 2 channels and a function:
+	//   - a channel into which new actions will be sent
+	//   - a channel into which any errors will be sent
+	//   - a function that the client can call to stop the streaming and close the channels
+	// The streaming also stops when the context is done, including any request in flight.
+	ModActions(ctx context.Context, subreddit string, opts ...StreamOpt) (<-chan *ModAction, <-chan error, func())
+
+	// ModQueue streams the posts and comments of the specified subreddit's modqueue, i.e. the ones
+	// that require a moderator's review. To stream from all the subreddits you moderate, specify "mod".
+	// It returns 2 channels and a function:
+	//   - a channel into which new posts and comments will be sent
+	//   - a channel into which any errors will be sent
+	//   - a function that the client can call to stop the streaming and close the channels
+	// The streaming also stops when the context is done, including any request in flight.
+	ModQueue(ctx context.Context, subreddit string, opts ...StreamOpt) (<-chan *ModerationItem, <-chan error, func())
+
+	// PostReplies streams the replies to your posts.
+	// See Inbox for more information.
+	PostReplies(ctx context.Context, opts ...StreamOpt) (<-chan *Message, <-chan error, func())
+
+	// Posts streams posts from the specified subreddit.
+	// It returns 2 channels and a function:
+	//   - a channel into which new posts will be sent
+	//   - a channel into which any errors will be sent
+	//   - a function that the client can call to stop the streaming and close the channels
+	// The streaming also stops when the context is done, including any request in flight.
+	// Because of the 100 post limit imposed by Reddit when fetching posts, some high-traffic
+	// streams might drop submissions between API requests, such as when streaming r/all.
+	Posts(ctx context.Context, subreddit string, opts ...StreamOpt) (<-chan *Post, <-chan error, func())
+
+	// Reports streams the reported posts and comments of the specified subreddit.
+	// See ModQueue for more information.
+	Reports(ctx context.Context, subreddit string, opts ...StreamOpt) (<-chan *ModerationItem, <-chan error, func())
+
+	// Spam streams the posts and comments of the specified subreddit that were marked as spam.
+	// See ModQueue for more information.
+	Spam(ctx context.Context, subreddit string, opts ...StreamOpt) (<-chan *ModerationItem, <-chan error, func())
+
+	// Unmoderated streams the posts of the specified subreddit that haven't been reviewed by a moderator.
+	// See ModQueue for more information.
+	Unmoderated(ctx context.Context, subreddit string, opts ...StreamOpt) (<-chan *ModerationItem, <-chan error, func())
+}
+
+// SubredditAPI is the interface implemented by SubredditService.
+// It can be used to substitute the service of a Client, e.g. with a mock in tests.
+type SubredditAPI interface {
+	// Approved returns the list of subreddits you are an approved user in.
+	Approved(ctx context.Context, opts *ListSubredditOptions) (*Subreddits, *Response, error)
+
+	// Banned gets banned users from the subreddit.
+	Banned(ctx context.Context, subreddit string, opts *ListOptions) (*Bans, *Response, error)
+
+	// Contributors gets contributors (also known as approved users) from the subreddit.
+	Contributors(ctx context.Context, subreddit string, opts *ListOptions) (*Relationships, *Response, error)
+
+	// ControversialPosts returns the most controversial posts from the specified subreddit.
+	// To search through multiple, separate the names with a plus (+), e.g. "golang+test".
+	// If none are defined, it returns the ones from your subscribed subreddits.
+	// To search through all, just specify "all".
+	// To search through all and filter out subreddits, provide "all-name1-name2".
+	ControversialPosts(ctx context.Context, subreddit string, opts *ListPostOptions) (*Posts, *Response, error)
+
+	// Default returns default subreddits.
+	Default(ctx context.Context, opts *ListSubredditOptions) (*Subreddits, *Response, error)
+
+	// Favorite favorites the subreddit.
+	Favorite(ctx context.Context, subreddit string) (*Response, error)
+
+	// Get gets a subreddit by name.
+	Get(ctx context.Context, name string) (*Subreddit, *Response, error)
+
+	// GetSticky1 returns the first stickied post on a subreddit (if it exists).
+	GetSticky1(ctx context.Context, subreddit string) (*PostAndComments, *Response, error)
+
+	// GetSticky2 returns the second stickied post on a subreddit (if it exists).
+	GetSticky2(ctx context.Context, subreddit string) (*PostAndComments, *Response, error)
+
+	// Gold returns gold subreddits (i.e. only accessible to users with gold).
+	// It seems like it returns an empty list if you don't have gold.
+	Gold(ctx context.Context, opts *ListSubredditOptions) (*Subreddits, *Response, error)
+
+	// HotPosts returns the hottest posts from the specified subreddit.
+	// To search through multiple, separate the names with a plus (+), e.g. "golang+test".
+	// If none are defined, it returns the ones from your subscribed subreddits.
+	// To search through all, just specify "all".
+	// To search through all and filter out subreddits, provide "all-name1-name2".
+	// Note: when looking for hot posts in a subreddit, it will include the stickied
+	// posts (if any) PLUS posts from the limit parameter (25 by default).
+	HotPosts(ctx context.Context, subreddit string, opts *ListOptions) (*Posts, *Response, error)
+
+	// Moderated returns the list of subreddits you are a moderator of.
+	Moderated(ctx context.Context, opts *ListSubredditOptions) (*Subreddits, *Response, error)
+
+	// Moderators gets the moderators of the subreddit.
+	Moderators(ctx context.Context, subreddit string) ([]*Moderator, *Response, error)
+
+	// Muted gets muted users from the subreddit.
+	Muted(ctx context.Context, subreddit string, opts *ListOptions) (*Relationships, *Response, error)
+
+	// New returns new subreddits.
+	New(ctx context.Context, opts *ListSubredditOptions) (*Subreddits, *Response, error)
+
+	// NewPosts returns the newest posts from the specified subreddit.
+	// To search through multiple, separate the names with a plus (+), e.g. "golang+test".
+	// If none are defined, it returns the ones from your subscribed subreddits.
+	// To search through all, just specify "all".
+	// To search through all and filter out subreddits, provide "all-name1-name2".
+	NewPosts(ctx context.Context, subreddit string, opts *ListOptions) (*Posts, *Response, error)
+
+	// Popular returns popular subreddits.
+	Popular(ctx context.Context, opts *ListSubredditOptions) (*Subreddits, *Response, error)
+
+	// Random returns a random SFW subreddit.
+	Random(ctx context.Context) (*Subreddit, *Response, error)
+
+	// RandomNSFW returns a random NSFW subreddit.
+	RandomNSFW(ctx context.Context) (*Subreddit, *Response, error)
+
+	// RisingPosts returns the rising posts from the specified subreddit.
+	// To search through multiple, separate the names with a plus (+), e.g. "golang+test".
+	// If none are defined, it returns the ones from your subscribed subreddits.
+	// To search through all, just specify "all".
+	// To search through all and filter out subreddits, provide "all-name1-name2".
+	RisingPosts(ctx context.Context, subreddit string, opts *ListOptions) (*Posts, *Response, error)
+
+	// Search searches for subreddits.
+	Search(ctx context.Context, query string, opts *ListSubredditOptions) (*Subreddits, *Response, error)
+
+	// SearchNames searches for subreddits with names beginning with the query provided.
+	SearchNames(ctx context.Context, query string) ([]string, *Response, error)
+
+	// SearchPosts searches for posts in the specified subreddit.
+	// To search through multiple, separate the names with a plus (+), e.g. "golang+test".
+	// If no subreddit is provided, the search is run against r/all.
+	SearchPosts(ctx context.Context, query string, subreddit string, opts *ListPostSearchOptions) (*Posts, *Response, error)
+
+	// SubmissionText gets the submission text for the subreddit.
+	// This text is set by the subreddit moderators and intended to be displayed on the submission form.
+	SubmissionText(ctx context.Context, name string) (string, *Response, error)
+
+	// Subscribe subscribes to subreddits based on their names.
+	Subscribe(ctx context.Context, subreddits ...string) (*Response, error)
+
+	// SubscribeByID subscribes to subreddits based on their id.
+	SubscribeByID(ctx context.Context, ids ...string) (*Response, error)
+
+	// Subscribed returns the list of subreddits you are subscribed to.
+	Subscribed(ctx context.Context, opts *ListSubredditOptions) (*Subreddits, *Response, error)
+
+	// TopPosts returns the top posts from the specified subreddit.
+	// To search through multiple, separate the names with a plus (+), e.g. "golang+test".
+	// If none are defined, it returns the ones from your subscribed subreddits.
+	// To search through all, just specify "all".
+	// To search through all and filter out subreddits, provide "all-name1-name2".
+	TopPosts(ctx context.Context, subreddit string, opts *ListPostOptions) (*Posts, *Response, error)
+
+	// Unfavorite unfavorites the subreddit.
+	Unfavorite(ctx context.Context, subreddit string) (*Response, error)
+
+	// Unsubscribe unsubscribes from subreddits based on their names.
+	Unsubscribe(ctx context.Context, subreddits ...string) (*Response, error)
+
+	// UnsubscribeByID unsubscribes from subreddits based on their id.
+	UnsubscribeByID(ctx context.Context, ids ...string) (*Response, error)
+
+	// WikiBanned gets banned users from the subreddit.
+	WikiBanned(ctx context.Context, subreddit string, opts *ListOptions) (*Bans, *Response, error)
+
+	// WikiContributors gets contributors of the wiki from the subreddit.
+	WikiContributors(ctx context.Context, subreddit string, opts *ListOptions) (*Relationships, *Response, error)
+}
+
+// UserAPI is the interface implemented by UserService.
+// It can be used to substitute the service of a Client, e.g. with a mock in tests.
+type UserAPI interface {
+	// Block blocks a user.
+	Block(ctx context.Context, username string) (*Blocked, *Response, error)
+
+	// BlockByID blocks a user via their full id.
+	BlockByID(ctx context.Context, id string) (*Blocked, *Response, error)
+
+	// Comments returns a list of your comments.
+	Comments(ctx context.Context, opts *ListUserOverviewOptions) (*Comments, *Response, error)
+
+	// CommentsOf returns a list of the user's comments.
+	CommentsOf(ctx context.Context, username string, opts *ListUserOverviewOptions) (*Comments, *Response, error)
+
+	// Downvoted returns a list of your downvoted posts.
+	Downvoted(ctx context.Context, opts *ListUserOverviewOptions) (*Posts, *Response, error)
+
+	// DownvotedOf returns a list of the user's downvoted posts.
+	// The user's votes must be public for this to work (unless the user is you).
+	DownvotedOf(ctx context.Context, username string, opts *ListUserOverviewOptions) (*Posts, *Response, error)
+
+	// Friend friends a user.
+	Friend(ctx context.Context, username string) (*Relationship, *Response, error)
+
+	// Get returns information about the user.
+	Get(ctx context.Context, username string) (*User, *Response, error)
+
+	// GetFriendship returns relationship details with the specified user.
+	// If the user is not your friend, it will return an error.
+	GetFriendship(ctx context.Context, username string) (*Relationship, *Response, error)
+
+	// GetMultipleByID returns multiple users from their full IDs.
+	// The response body is a map where the keys are the IDs (if they exist), and the value is the user.
+	GetMultipleByID(ctx context.Context, ids ...string) (map[string]*UserSummary, *Response, error)
+
+	// Gilded returns a list of the user's gilded posts.
+	Gilded(ctx context.Context, opts *ListUserOverviewOptions) (*Posts, *Response, error)
+
+	// Hidden returns a list of the user's hidden posts.
+	Hidden(ctx context.Context, opts *ListUserOverviewOptions) (*Posts, *Response, error)
+
+	// New gets the most recently created user subreddits.
+	New(ctx context.Context, opts *ListUserOverviewOptions) (*Subreddits, *Response, error)
+
+	// Overview returns a list of your posts and comments.
+	Overview(ctx context.Context, opts *ListUserOverviewOptions) (*Posts, *Comments, *Response, error)
+
+	// OverviewOf returns a list of the user's posts and comments.
+	OverviewOf(ctx context.Context, username string, opts *ListUserOverviewOptions) (*Posts, *Comments, *Response, error)
+
+	// Popular gets the user subreddits with the most activity.
+	Popular(ctx context.Context, opts *ListOptions) (*Subreddits, *Response, error)
+
+	// Posts returns a list of your posts.
+	Posts(ctx context.Context, opts *ListUserOverviewOptions) (*Posts, *Response, error)
+
+	// PostsOf returns a list of the user's posts.
+	PostsOf(ctx context.Context, username string, opts *ListUserOverviewOptions) (*Posts, *Response, error)
+
+	// Saved returns a list of the user's saved posts and comments.
+	Saved(ctx context.Context, opts *ListUserOverviewOptions) (*Posts, *Comments, *Response, error)
+
+	// Search searches for users.
+	// todo: maybe include the sort option? (relevance, activity)
+	Search(ctx context.Context, query string, opts *ListOptions) (*Users, *Response, error)
+
+	// Trophies returns a list of your trophies.
+	Trophies(ctx context.Context) ([]Trophy, *Response, error)
+
+	// TrophiesOf returns a list of the specified user's trophies.
+	TrophiesOf(ctx context.Context, username string) ([]Trophy, *Response, error)
+
+	// Unblock unblocks a user.
+	Unblock(ctx context.Context, username string) (*Response, error)
+
+	// UnblockByID unblocks a user via their full id.
+	UnblockByID(ctx context.Context, id string) (*Response, error)
+
+	// Unfriend unfriends a user.
+	Unfriend(ctx context.Context, username string) (*Response, error)
+
+	// Upvoted returns a list of your upvoted posts.
+	Upvoted(ctx context.Context, opts *ListUserOverviewOptions) (*Posts, *Response, error)
+
+	// UpvotedOf returns a list of the user's upvoted posts.
+	// The user's votes must be public for this to work (unless the user is you).
+	UpvotedOf(ctx context.Context, username string, opts *ListUserOverviewOptions) (*Posts, *Response, error)
+
+	// UsernameAvailable checks whether a username is available for registration.
+	UsernameAvailable(ctx context.Context, username string) (bool, *Response, error)
+}
+
+var (
+	_ AccountAPI    = &AccountService{}
+	_ CollectionAPI = &CollectionService{}
+	_ CommentAPI    = &CommentService{}
+	_ EmojiAPI      = &EmojiService{}
+	_ FlairAPI      = &FlairService{}
+	_ GoldAPI       = &GoldService{}
+	_ ListingsAPI   = &ListingsService{}
+	_ MessageAPI    = &MessageService{}
+	_ ModerationAPI = &ModerationService{}
+	_ MultiAPI      = &MultiService{}
+	_ PostAPI       = &PostService{}
+	_ StreamAPI     = &StreamService{}
+	_ SubredditAPI  = &SubredditService{}
+	_ UserAPI       = &UserService{}
+)
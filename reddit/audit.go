@@ -0,0 +1,211 @@
+package reddit
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// AuditEntry is the record of a mutating action performed by the client.
+type AuditEntry struct {
+	Time time.Time `json:"time"`
+	// Service call that performed the action, e.g. "Moderation.Remove". See CallInfo for more information.
+	Action   string `json:"action"`
+	Endpoint string `json:"endpoint"`
+	Method   string `json:"method"`
+	// Full ID of the thing the action was performed on, if any.
+	Target string `json:"target,omitempty"`
+	// Form parameters of the request (or its query parameters if it doesn't have a form body),
+	// with the values of secrets replaced by AuditRedacted.
+	Params url.Values `json:"params,omitempty"`
+
+	// Whether the action succeeded, i.e. the request was sent and Reddit didn't return an error.
+	Success    bool   `json:"success"`
+	StatusCode int    `json:"status_code,omitempty"`
+	Error      string `json:"error,omitempty"`
+	// Whether the action wasn't actually performed because the client is in dry-run mode.
+	DryRun bool `json:"dry_run,omitempty"`
+}
+
+// AuditRedacted replaces the values of secrets in audit entries and dry-run requests.
+const AuditRedacted = "REDACTED"
+
+// auditSecretParams are the parameters whose values are redacted from audit entries and dry-run requests.
+var auditSecretParams = map[string]bool{
+	"password":      true,
+	"passwd":        true,
+	"client_secret": true,
+	"code":          true,
+	"token":         true,
+	"access_token":  true,
+	"refresh_token": true,
+}
+
+// auditTargetParams are the parameters that can contain the full ID of the target of an action,
+// in order of precedence.
+var auditTargetParams = []string{"id", "thing_id", "parent", "link_id", "sr"}
+
+var fullIDRegex = regexp.MustCompile(`^t[1-6]_[a-z0-9]+$`)
+
+// AuditSink records the mutating actions performed by the client.
+// It is called after every mutating request, once the request's retries are done.
+// See WithAuditSink for more information.
+type AuditSink interface {
+	Audit(entry *AuditEntry) error
+}
+
+// AuditSinkFunc is an adapter to allow the use of ordinary functions as audit sinks.
+type AuditSinkFunc func(entry *AuditEntry) error
+
+// Audit calls f(entry).
+func (f AuditSinkFunc) Audit(entry *AuditEntry) error {
+	return f(entry)
+}
+
+// AuditError is returned when a mutating action couldn't be recorded by the client's audit sink.
+// The action itself may have succeeded: see Entry.
+type AuditError struct {
+	Entry *AuditEntry
+	Err   error
+}
+
+func (e *AuditError) Error() string {
+	return fmt.Sprintf("failed to audit %s: %v", e.Entry.Action, e.Err)
+}
+
+func (e *AuditError) Unwrap() error {
+	return e.Err
+}
+
+// newAuditEntry returns the record of the action performed with the request.
+func newAuditEntry(req *http.Request, info *CallInfo) *AuditEntry {
+	entry := &AuditEntry{
+		Time:     time.Now(),
+		Action:   info.Operation,
+		Endpoint: info.Endpoint,
+		Method:   req.Method,
+		Params:   auditParams(req),
+	}
+
+	for _, key := range auditTargetParams {
+		if value := entry.Params.Get(key); fullIDRegex.MatchString(value) {
+			entry.Target = value
+			break
+		}
+	}
+
+	return entry
+}
+
+// auditParams returns the redacted form parameters of the request, or its query parameters
+// if it doesn't have a form body.
+func auditParams(req *http.Request) url.Values {
+	params := req.URL.Query()
+
+	if req.GetBody != nil && strings.HasPrefix(req.Header.Get(headerContentType), mediaTypeForm) {
+		if form, err := readForm(req); err == nil {
+			params = form
+		}
+	}
+
+	if len(params) == 0 {
+		return nil
+	}
+	return redactParams(params)
+}
+
+// redactParams replaces the values of the secrets in params by AuditRedacted, and returns params.
+func redactParams(params url.Values) url.Values {
+	for key := range params {
+		if auditSecretParams[key] {
+			params[key] = []string{AuditRedacted}
+		}
+	}
+	return params
+}
+
+// readForm returns the form values of the request's body, leaving the body unread.
+func readForm(req *http.Request) (url.Values, error) {
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	data, err := ioutil.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+	return url.ParseQuery(string(data))
+}
+
+// audit records the outcome of a mutating request with the client's audit sink, if it has one.
+// If the sink fails, an *AuditError is returned in place of a nil err.
+func (c *Client) audit(req *http.Request, info *CallInfo, resp *Response, err error) error {
+	if c.auditSink == nil || !isMutating(req.Method, info.Endpoint) {
+		return err
+	}
+
+	entry := newAuditEntry(req, info)
+	entry.Success = err == nil
+	entry.DryRun = c.dryRun != nil
+	if resp != nil {
+		entry.StatusCode = resp.StatusCode
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+
+	if auditErr := c.auditSink.Audit(entry); auditErr != nil && err == nil {
+		return &AuditError{Entry: entry, Err: auditErr}
+	}
+	return err
+}
+
+// JSONLAuditSink appends audit entries to a file, as JSON objects separated by newlines.
+// Each entry is synced to disk before Audit returns. It is safe for concurrent use.
+type JSONLAuditSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewJSONLAuditSink returns a sink that appends entries to the file at path.
+// The file is created if it doesn't exist.
+func NewJSONLAuditSink(path string) (*JSONLAuditSink, error) {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return &JSONLAuditSink{file: file}, nil
+}
+
+// Audit appends the entry to the file.
+func (s *JSONLAuditSink) Audit(entry *AuditEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.file.Write(data); err != nil {
+		return err
+	}
+	return s.file.Sync()
+}
+
+// Close closes the file.
+func (s *JSONLAuditSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
@@ -0,0 +1,180 @@
+package reddit
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type auditLog []*AuditEntry
+
+func (l *auditLog) Audit(entry *AuditEntry) error {
+	*l = append(*l, entry)
+	return nil
+}
+
+func TestAuditSink(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	log := new(auditLog)
+	require.NoError(t, WithAuditSink(log)(client))
+
+	mux.HandleFunc("/r/test/about", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"kind":"t5","data":{"display_name":"test"}}`)
+	})
+	mux.HandleFunc("/api/remove", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+	})
+	mux.HandleFunc("/api/approve", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	_, _, err := client.Subreddit.Get(ctx, "test")
+	require.NoError(t, err)
+	require.Empty(t, *log)
+
+	_, err = client.Moderation.Remove(ctx, "t3_test")
+	require.NoError(t, err)
+
+	_, err = client.Moderation.Approve(ctx, "t1_test")
+	require.Error(t, err)
+
+	require.Len(t, *log, 2)
+
+	entry := (*log)[0]
+	require.False(t, entry.Time.IsZero())
+	require.Equal(t, "Moderation.Remove", entry.Action)
+	require.Equal(t, "api/remove", entry.Endpoint)
+	require.Equal(t, http.MethodPost, entry.Method)
+	require.Equal(t, "t3_test", entry.Target)
+	require.Equal(t, url.Values{"id": {"t3_test"}, "spam": {"false"}}, entry.Params)
+	require.True(t, entry.Success)
+	require.Equal(t, http.StatusOK, entry.StatusCode)
+	require.Empty(t, entry.Error)
+	require.False(t, entry.DryRun)
+
+	entry = (*log)[1]
+	require.Equal(t, "Moderation.Approve", entry.Action)
+	require.Equal(t, "t1_test", entry.Target)
+	require.False(t, entry.Success)
+	require.Equal(t, http.StatusForbidden, entry.StatusCode)
+	require.Equal(t, err.Error(), entry.Error)
+}
+
+func TestAuditSink_Redacted(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	log := new(auditLog)
+	require.NoError(t, WithAuditSink(log)(client))
+
+	mux.HandleFunc("/api/v1/revoke_token", func(w http.ResponseWriter, r *http.Request) {})
+
+	_, err := client.Account.RevokeAccessToken(ctx, "token1")
+	require.NoError(t, err)
+
+	require.Len(t, *log, 1)
+	require.Equal(t, "Account.RevokeAccessToken", (*log)[0].Action)
+	require.Equal(t, url.Values{"token": {AuditRedacted}, "token_type_hint": {"access_token"}}, (*log)[0].Params)
+	require.True(t, (*log)[0].Success)
+}
+
+func TestAuditSink_DryRun(t *testing.T) {
+	client, _, teardown := setup()
+	defer teardown()
+
+	log := new(auditLog)
+	require.NoError(t, WithAuditSink(log)(client))
+	require.NoError(t, WithDryRun(func(*DryRunRequest) {})(client))
+
+	_, err := client.Moderation.Remove(ctx, "t3_test")
+	require.NoError(t, err)
+
+	require.Len(t, *log, 1)
+	require.True(t, (*log)[0].DryRun)
+	require.True(t, (*log)[0].Success)
+}
+
+func TestAuditSink_Error(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	sinkErr := errors.New("disk full")
+	require.NoError(t, WithAuditSink(AuditSinkFunc(func(*AuditEntry) error {
+		return sinkErr
+	}))(client))
+
+	mux.HandleFunc("/api/remove", func(w http.ResponseWriter, r *http.Request) {})
+
+	_, err := client.Moderation.Remove(ctx, "t3_test")
+	require.True(t, errors.Is(err, sinkErr))
+
+	var auditErr *AuditError
+	require.True(t, errors.As(err, &auditErr))
+	require.True(t, auditErr.Entry.Success)
+	require.EqualError(t, err, "failed to audit Moderation.Remove: disk full")
+}
+
+func TestJSONLAuditSink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "go-reddit")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "audit.jsonl")
+
+	sink, err := NewJSONLAuditSink(path)
+	require.NoError(t, err)
+
+	require.NoError(t, sink.Audit(&AuditEntry{Action: "Moderation.Remove", Target: "t3_a", Success: true}))
+	require.NoError(t, sink.Audit(&AuditEntry{Action: "Moderation.Approve", Target: "t1_b", Error: "forbidden"}))
+	require.NoError(t, sink.Close())
+
+	// entries are appended to existing files
+	sink, err = NewJSONLAuditSink(path)
+	require.NoError(t, err)
+	require.NoError(t, sink.Audit(&AuditEntry{Action: "Message.Send"}))
+	require.NoError(t, sink.Close())
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+
+	var actions []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		entry := new(AuditEntry)
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), entry))
+		actions = append(actions, entry.Action)
+	}
+	require.NoError(t, scanner.Err())
+	require.Equal(t, []string{"Moderation.Remove", "Moderation.Approve", "Message.Send"}, actions)
+}
+
+func TestAuditSink_ReadOnlyPost(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	log := new(auditLog)
+	require.NoError(t, WithAuditSink(log)(client))
+
+	mux.HandleFunc("/api/morechildren", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"json":{"data":{"things":[]}}}`)
+	})
+
+	_, err := client.Post.LoadMoreComments(ctx, &PostAndComments{
+		Post: &Post{FullID: "t3_123"},
+		More: &More{Children: []string{"abc"}},
+	})
+	require.NoError(t, err)
+	require.Empty(t, *log)
+}
@@ -0,0 +1,382 @@
+package reddit
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CacheEntry is a response stored in a Cache.
+type CacheEntry struct {
+	// The resource the response belongs to, e.g. "r/golang".
+	// Successful writes to the resource invalidate the entry.
+	Resource string
+
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+
+	// Time after which the entry is stale. A stale entry that has an ETag
+	// is revalidated with Reddit instead of being fetched again.
+	Expires time.Time
+}
+
+// Cache stores responses to GET requests, keyed by their URL.
+type Cache interface {
+	// Get returns the entry stored under the key, if any. It can be stale.
+	Get(key string) (*CacheEntry, bool)
+	// Set stores the entry under the key.
+	Set(key string, entry *CacheEntry)
+	// Invalidate removes all the entries of the resource.
+	Invalidate(resource string)
+}
+
+// CacheConfig configures the response cache of a client.
+type CacheConfig struct {
+	Cache Cache
+
+	// How long responses are served from the cache, by operation, e.g. "Subreddit.Get".
+	// See CallInfo for more information about operations.
+	TTLs map[string]time.Duration
+
+	// How long responses of operations that aren't in TTLs are served from the cache.
+	// If it is 0, only the responses of the operations in TTLs are cached.
+	DefaultTTL time.Duration
+}
+
+func (c *CacheConfig) ttl(operation string) time.Duration {
+	if ttl, ok := c.TTLs[operation]; ok {
+		return ttl
+	}
+	return c.DefaultTTL
+}
+
+type bypassCacheKey struct{}
+
+// BypassCache returns a context that makes requests skip the response cache of the client,
+// e.g. when you need fresh data. The fresh responses are still stored in the cache.
+func BypassCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassCacheKey{}, true)
+}
+
+func cacheBypassed(ctx context.Context) bool {
+	bypass, _ := ctx.Value(bypassCacheKey{}).(bool)
+	return bypass
+}
+
+var (
+	// Matches the endpoints of subreddits, e.g. r/golang/about
+	subredditEndpointRegex = regexp.MustCompile(`^r/([^/]+)`)
+	// Matches the emoji endpoints of subreddits, e.g. api/v1/golang/emojis/all
+	emojiEndpointRegex = regexp.MustCompile(`^api/v1/([^/]+)/emoji`)
+	// Matches the about endpoints of subreddits, e.g. r/golang/about
+	subredditAboutEndpointRegex = regexp.MustCompile(`^r/[^/]+/about$`)
+)
+
+// cacheResource returns the resource an endpoint belongs to.
+// Endpoints of a subreddit all belong to that subreddit, e.g. "r/golang".
+// Other endpoints are their own resource.
+func cacheResource(endpoint string) string {
+	endpoint = strings.TrimSuffix(endpoint, ".json")
+	if m := subredditEndpointRegex.FindStringSubmatch(endpoint); m != nil {
+		return "r/" + strings.ToLower(m[1])
+	}
+	if m := emojiEndpointRegex.FindStringSubmatch(endpoint); m != nil {
+		return "r/" + strings.ToLower(m[1])
+	}
+	return endpoint
+}
+
+// subredditIDs maps the full IDs of subreddits to their resources, e.g. "t5_2rc7j" to "r/golang",
+// so that writes naming subreddits by their full ID invalidate them. The IDs are learned from the
+// responses of the subreddits' about endpoints, i.e. of Subreddit.Get.
+type subredditIDs struct {
+	mu        sync.Mutex
+	resources map[string]string
+}
+
+// learn records the full ID of the subreddit if the response is the one of its about endpoint.
+func (s *subredditIDs) learn(endpoint string, body []byte) {
+	if !isSubredditAboutEndpoint(endpoint) {
+		return
+	}
+
+	var root struct {
+		Data struct {
+			FullID string `json:"name"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &root); err != nil || root.Data.FullID == "" {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.resources == nil {
+		s.resources = make(map[string]string)
+	}
+	s.resources[root.Data.FullID] = cacheResource(endpoint)
+}
+
+func (s *subredditIDs) resource(fullID string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	resource, ok := s.resources[fullID]
+	return resource, ok
+}
+
+func isSubredditAboutEndpoint(endpoint string) bool {
+	return subredditAboutEndpointRegex.MatchString(strings.TrimSuffix(endpoint, ".json"))
+}
+
+// writeResources returns the resources modified by a write request.
+// Besides the one of its endpoint, the subreddits in the sr_name form value are
+// modified, e.g. by api/subscribe, and so are the ones whose full IDs are in the
+// id or sr form values, e.g. by api/leavemoderator, if their IDs are known.
+func writeResources(req *http.Request, endpoint string, subreddits *subredditIDs) []string {
+	resources := []string{cacheResource(endpoint)}
+
+	if req.GetBody == nil {
+		return resources
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return resources
+	}
+	defer body.Close()
+
+	b, err := ioutil.ReadAll(body)
+	if err != nil {
+		return resources
+	}
+	form, err := url.ParseQuery(string(b))
+	if err != nil {
+		return resources
+	}
+
+	for _, name := range strings.Split(form.Get("sr_name"), ",") {
+		if name != "" {
+			resources = append(resources, "r/"+strings.ToLower(name))
+		}
+	}
+	for _, key := range []string{"id", "sr"} {
+		for _, id := range strings.Split(form.Get(key), ",") {
+			if !strings.HasPrefix(id, kindSubreddit+"_") {
+				continue
+			}
+			if resource, ok := subreddits.resource(id); ok {
+				resources = append(resources, resource)
+			}
+		}
+	}
+
+	return resources
+}
+
+// Rate limit headers aren't cached, since they're only valid for the response they came with.
+var uncachedHeaders = []string{headerRateLimitUsed, headerRateLimitRemaining, headerRateLimitReset}
+
+// cacheInterceptor returns an interceptor that serves GET requests from the cache
+// and invalidates the cache on successful writes. It records the full IDs of the
+// subreddits it sees in subreddits.
+func cacheInterceptor(config *CacheConfig, subreddits *subredditIDs) Interceptor {
+	return func(req *http.Request, info *CallInfo, next RoundTripFunc) (*http.Response, error) {
+		if req.Method != http.MethodGet {
+			resp, err := next(req)
+			if err == nil && resp.StatusCode < http.StatusBadRequest {
+				for _, resource := range writeResources(req, info.Endpoint, subreddits) {
+					config.Cache.Invalidate(resource)
+				}
+			}
+			return resp, err
+		}
+
+		ttl := config.ttl(info.Operation)
+		if ttl <= 0 {
+			resp, err := next(req)
+			if err != nil || resp.StatusCode != http.StatusOK || !isSubredditAboutEndpoint(info.Endpoint) {
+				return resp, err
+			}
+
+			body, err := readResponseBody(resp)
+			if err != nil {
+				return nil, err
+			}
+			subreddits.learn(info.Endpoint, body)
+			return resp, nil
+		}
+
+		key := req.URL.String()
+		entry, ok := config.Cache.Get(key)
+		if ok && !cacheBypassed(req.Context()) {
+			if time.Now().Before(entry.Expires) {
+				return entry.response(req), nil
+			}
+
+			if etag := entry.Header.Get("ETag"); etag != "" {
+				req = req.Clone(req.Context())
+				req.Header.Set("If-None-Match", etag)
+			}
+		}
+
+		resp, err := next(req)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode == http.StatusNotModified && ok {
+			resp.Body.Close()
+
+			refreshed := *entry
+			refreshed.Expires = time.Now().Add(ttl)
+			config.Cache.Set(key, &refreshed)
+
+			return refreshed.response(req), nil
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			return resp, nil
+		}
+
+		body, err := readResponseBody(resp)
+		if err != nil {
+			return nil, err
+		}
+		subreddits.learn(info.Endpoint, body)
+
+		header := resp.Header.Clone()
+		for _, h := range uncachedHeaders {
+			header.Del(h)
+		}
+
+		config.Cache.Set(key, &CacheEntry{
+			Resource:   cacheResource(info.Endpoint),
+			StatusCode: resp.StatusCode,
+			Header:     header,
+			Body:       body,
+			Expires:    time.Now().Add(ttl),
+		})
+
+		return resp, nil
+	}
+}
+
+// readResponseBody reads and closes the body of the response, and replaces it with
+// a copy so that it can still be read.
+func readResponseBody(resp *http.Response) ([]byte, error) {
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// response returns an HTTP response to the request built from the entry.
+func (e *CacheEntry) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode)),
+		StatusCode:    e.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.Header.Clone(),
+		Body:          ioutil.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+		Request:       req,
+	}
+}
+
+// LRUCache is an in-memory Cache that holds a limited number of entries.
+// When it is full, the least recently used entry is evicted to make room for new ones.
+// It is safe for concurrent use.
+type LRUCache struct {
+	mu      sync.Mutex
+	size    int
+	ll      *list.List
+	entries map[string]*list.Element
+}
+
+type lruItem struct {
+	key   string
+	entry *CacheEntry
+}
+
+// NewLRUCache returns an in-memory cache that holds up to size entries.
+func NewLRUCache(size int) *LRUCache {
+	if size < 1 {
+		size = 1
+	}
+	return &LRUCache{
+		size:    size,
+		ll:      list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+// Get returns the entry stored under the key, if any.
+func (c *LRUCache) Get(key string) (*CacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.ll.MoveToFront(el)
+	return el.Value.(*lruItem).entry, true
+}
+
+// Set stores the entry under the key, evicting the least recently used entry if the cache is full.
+func (c *LRUCache) Set(key string, entry *CacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[key]; ok {
+		el.Value.(*lruItem).entry = entry
+		c.ll.MoveToFront(el)
+		return
+	}
+
+	c.entries[key] = c.ll.PushFront(&lruItem{key: key, entry: entry})
+	if c.ll.Len() > c.size {
+		c.remove(c.ll.Back())
+	}
+}
+
+// Invalidate removes all the entries of the resource.
+func (c *LRUCache) Invalidate(resource string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for el := c.ll.Front(); el != nil; {
+		next := el.Next()
+		if el.Value.(*lruItem).entry.Resource == resource {
+			c.remove(el)
+		}
+		el = next
+	}
+}
+
+// Len returns the number of entries in the cache.
+func (c *LRUCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len()
+}
+
+func (c *LRUCache) remove(el *list.Element) {
+	c.ll.Remove(el)
+	delete(c.entries, el.Value.(*lruItem).key)
+}
@@ -0,0 +1,236 @@
+package reddit
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func setupCache(t *testing.T, client *Client, config CacheConfig) *LRUCache {
+	cache := NewLRUCache(10)
+	config.Cache = cache
+	require.NoError(t, WithCache(config)(client))
+	return cache
+}
+
+func TestCache(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	cache := setupCache(t, client, CacheConfig{TTLs: map[string]time.Duration{"Subreddit.Get": time.Minute}})
+
+	requests := 0
+	mux.HandleFunc("/r/test/about", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		requests++
+		w.Header().Set(headerRateLimitRemaining, "500")
+		fmt.Fprint(w, `{"kind":"t5","data":{"display_name":"test"}}`)
+	})
+
+	for i := 0; i < 3; i++ {
+		subreddit, _, err := client.Subreddit.Get(ctx, "test")
+		require.NoError(t, err)
+		require.Equal(t, "test", subreddit.Name)
+	}
+	require.Equal(t, 1, requests)
+	require.Equal(t, 1, cache.Len())
+
+	u, err := client.BaseURL.Parse("r/test/about")
+	require.NoError(t, err)
+
+	entry, ok := cache.Get(u.String())
+	require.True(t, ok)
+	require.Equal(t, "r/test", entry.Resource)
+	require.Empty(t, entry.Header.Get(headerRateLimitRemaining))
+
+	_, _, err = client.Subreddit.Get(BypassCache(ctx), "test")
+	require.NoError(t, err)
+	require.Equal(t, 2, requests)
+}
+
+func TestCache_NoTTL(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	cache := setupCache(t, client, CacheConfig{TTLs: map[string]time.Duration{"Subreddit.Get": time.Minute}})
+
+	requests := 0
+	mux.HandleFunc("/r/test/about/moderators", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `{"kind":"UserList","data":{"children":[]}}`)
+	})
+
+	for i := 0; i < 2; i++ {
+		_, _, err := client.Subreddit.Moderators(ctx, "test")
+		require.NoError(t, err)
+	}
+	require.Equal(t, 2, requests)
+	require.Equal(t, 0, cache.Len())
+}
+
+func TestCache_Invalidate(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	cache := setupCache(t, client, CacheConfig{DefaultTTL: time.Minute})
+
+	requests := 0
+	mux.HandleFunc("/r/test/about", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `{"kind":"t5","data":{"display_name":"test"}}`)
+	})
+	mux.HandleFunc("/r/golang/about", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"kind":"t5","data":{"display_name":"golang"}}`)
+	})
+	mux.HandleFunc("/api/subscribe", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+	})
+
+	_, _, err := client.Subreddit.Get(ctx, "test")
+	require.NoError(t, err)
+	_, _, err = client.Subreddit.Get(ctx, "golang")
+	require.NoError(t, err)
+	require.Equal(t, 2, cache.Len())
+
+	_, err = client.Subreddit.Subscribe(ctx, "test")
+	require.NoError(t, err)
+	require.Equal(t, 1, cache.Len())
+
+	_, _, err = client.Subreddit.Get(ctx, "test")
+	require.NoError(t, err)
+	require.Equal(t, 2, requests)
+}
+
+func TestCache_ETag(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	cache := setupCache(t, client, CacheConfig{DefaultTTL: time.Minute})
+
+	requests := 0
+	mux.HandleFunc("/r/test/about", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		fmt.Fprint(w, `{"kind":"t5","data":{"display_name":"test"}}`)
+	})
+
+	_, _, err := client.Subreddit.Get(ctx, "test")
+	require.NoError(t, err)
+
+	u, err := client.BaseURL.Parse("r/test/about")
+	require.NoError(t, err)
+
+	key := u.String()
+	entry, ok := cache.Get(key)
+	require.True(t, ok)
+	entry.Expires = time.Now().Add(-time.Second)
+
+	subreddit, _, err := client.Subreddit.Get(ctx, "test")
+	require.NoError(t, err)
+	require.Equal(t, "test", subreddit.Name)
+	require.Equal(t, 2, requests)
+
+	entry, _ = cache.Get(key)
+	require.True(t, entry.Expires.After(time.Now()))
+}
+
+func TestCacheResource(t *testing.T) {
+	require.Equal(t, "r/golang", cacheResource("r/GoLang/about"))
+	require.Equal(t, "r/golang", cacheResource("r/golang.json"))
+	require.Equal(t, "r/golang", cacheResource("api/v1/golang/emojis/all"))
+	require.Equal(t, "api/v1/me", cacheResource("api/v1/me"))
+}
+
+func TestLRUCache(t *testing.T) {
+	cache := NewLRUCache(2)
+
+	cache.Set("a", &CacheEntry{Resource: "r/a"})
+	cache.Set("b", &CacheEntry{Resource: "r/b"})
+
+	// makes b the least recently used entry
+	_, ok := cache.Get("a")
+	require.True(t, ok)
+
+	cache.Set("c", &CacheEntry{Resource: "r/a"})
+	require.Equal(t, 2, cache.Len())
+
+	_, ok = cache.Get("b")
+	require.False(t, ok)
+
+	cache.Invalidate("r/a")
+	require.Equal(t, 0, cache.Len())
+}
+
+func TestWriteResources(t *testing.T) {
+	form := url.Values{}
+	form.Set("sr_name", "Test,golang")
+
+	req, err := http.NewRequest(http.MethodPost, "https://oauth.reddit.com/api/subscribe", nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"api/subscribe"}, writeResources(req, "api/subscribe", new(subredditIDs)))
+
+	client, _ := NewClient(nil, nil)
+	req, err = client.NewRequestWithForm(http.MethodPost, "api/subscribe", form)
+	require.NoError(t, err)
+	require.Equal(t, []string{"api/subscribe", "r/test", "r/golang"}, writeResources(req, "api/subscribe", new(subredditIDs)))
+
+	subreddits := new(subredditIDs)
+	subreddits.learn("r/Test/about", []byte(`{"kind":"t5","data":{"name":"t5_test"}}`))
+	subreddits.learn("r/golang/hot", []byte(`{"kind":"t5","data":{"name":"t5_golang"}}`))
+
+	form = url.Values{}
+	form.Set("sr", "t5_test,t5_golang")
+	req, err = client.NewRequestWithForm(http.MethodPost, "api/subscribe", form)
+	require.NoError(t, err)
+	// the ID of r/golang wasn't learned from its about endpoint, so it's unknown
+	require.Equal(t, []string{"api/subscribe", "r/test"}, writeResources(req, "api/subscribe", subreddits))
+}
+
+func TestCache_InvalidateByFullID(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	cache := setupCache(t, client, CacheConfig{TTLs: map[string]time.Duration{"Subreddit.Moderators": time.Minute}})
+
+	mux.HandleFunc("/r/test/about", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"kind":"t5","data":{"name":"t5_test","display_name":"test"}}`)
+	})
+	requests := 0
+	mux.HandleFunc("/r/test/about/moderators", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `{"kind":"UserList","data":{"children":[]}}`)
+	})
+	mux.HandleFunc("/api/leavemoderator", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+	})
+
+	_, _, err := client.Subreddit.Moderators(ctx, "test")
+	require.NoError(t, err)
+	require.Equal(t, 1, cache.Len())
+
+	// the ID of the subreddit is unknown, so its entries are kept
+	_, err = client.Moderation.Leave(ctx, "t5_test")
+	require.NoError(t, err)
+	require.Equal(t, 1, cache.Len())
+
+	// Subreddit.Get isn't cached, but the client still learns the ID of the subreddit from it
+	subreddit, _, err := client.Subreddit.Get(ctx, "test")
+	require.NoError(t, err)
+	require.Equal(t, "test", subreddit.Name)
+
+	_, err = client.Moderation.Leave(ctx, "t5_test")
+	require.NoError(t, err)
+	require.Zero(t, cache.Len())
+
+	_, _, err = client.Subreddit.Moderators(ctx, "test")
+	require.NoError(t, err)
+	require.Equal(t, 2, requests)
+}
@@ -0,0 +1,377 @@
+// Package cassette provides an HTTP transport that records the requests made by a client
+// and their responses to a file, and replays them later on. This makes it possible to write
+// deterministic tests of code built on the reddit package, without hitting Reddit.
+//
+// Credentials and tokens are stripped from the recorded interactions, so cassettes can be
+// committed safely. In replay mode, requests are matched against the recorded ones by their
+// method, URL, and body, with the secrets stripped from them the same way.
+//
+//	recorder, err := cassette.New("testdata/posts.json", cassette.ModeReplay, nil)
+//	if err != nil {
+//		return err
+//	}
+//
+//	client, err := reddit.NewClient(recorder.Client(), credentials)
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Redacted replaces secrets in the recorded interactions.
+const Redacted = "REDACTED"
+
+// Mode determines whether a Recorder records or replays interactions.
+type Mode int
+
+const (
+	// ModeRecord sends requests through the underlying transport and records them.
+	ModeRecord Mode = iota
+	// ModeReplay serves requests from the recorded interactions, without sending them.
+	ModeReplay
+)
+
+// ErrNoMatch is returned in replay mode when a request doesn't match any of the recorded interactions.
+var ErrNoMatch = errors.New("cassette: no recorded interaction matches the request")
+
+var (
+	// Headers that are never recorded, since they carry credentials.
+	secretHeaders = []string{"Authorization", "Cookie", "Set-Cookie"}
+	// Form values and JSON fields that are redacted wherever they appear.
+	secretFields = []string{"password", "client_secret", "code", "token", "access_token", "refresh_token", "device_id"}
+)
+
+// Request is a recorded HTTP request.
+type Request struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// Response is a recorded HTTP response.
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Interaction is a request and the response it got.
+type Interaction struct {
+	Request  *Request  `json:"request"`
+	Response *Response `json:"response"`
+}
+
+// Cassette holds recorded interactions, in the order they happened.
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Recorder is an http.RoundTripper that records or replays interactions, depending on its mode.
+// It is safe for concurrent use.
+type Recorder struct {
+	mode      Mode
+	path      string
+	transport http.RoundTripper
+
+	mu       sync.Mutex
+	cassette *Cassette
+	// Interactions that have already been replayed.
+	replayed []bool
+}
+
+// New returns a recorder for the cassette at the path.
+// In record mode, requests are sent through transport (http.DefaultTransport if nil), and the
+// interactions are written to the path when Save is called. In replay mode, the cassette is
+// loaded from the path and transport isn't used.
+func New(path string, mode Mode, transport http.RoundTripper) (*Recorder, error) {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	r := &Recorder{
+		mode:      mode,
+		path:      path,
+		transport: transport,
+		cassette:  new(Cassette),
+	}
+
+	if mode == ModeReplay {
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(b, r.cassette); err != nil {
+			return nil, fmt.Errorf("cassette: %s: %w", path, err)
+		}
+		r.replayed = make([]bool, len(r.cassette.Interactions))
+	}
+
+	return r, nil
+}
+
+// Mode returns the mode of the recorder.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Client returns an HTTP client that uses the recorder as its transport.
+// It can be passed to reddit.NewClient.
+func (r *Recorder) Client() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	recorded := newRequest(req, body)
+
+	if r.mode == ModeReplay {
+		return r.replay(req, recorded)
+	}
+
+	// a RoundTripper mustn't modify the request, so the body is sent with a copy of it
+	if body != nil {
+		req = req.Clone(req.Context())
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	return r.record(req, recorded)
+}
+
+func (r *Recorder) replay(req *http.Request, recorded *Request) (*http.Response, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, interaction := range r.cassette.Interactions {
+		if r.replayed[i] || !interaction.Request.matches(recorded) {
+			continue
+		}
+		r.replayed[i] = true
+		return interaction.Response.httpResponse(req), nil
+	}
+
+	return nil, fmt.Errorf("%w: %s %s", ErrNoMatch, recorded.Method, recorded.URL)
+}
+
+func (r *Recorder) record(req *http.Request, recorded *Request) (*http.Response, error) {
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, &Interaction{
+		Request:  recorded,
+		Response: newResponse(resp, body),
+	})
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+// Save writes the recorded interactions to the path of the cassette.
+// It does nothing in replay mode.
+func (r *Recorder) Save() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	b, err := json.MarshalIndent(r.cassette, "", "\t")
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.path, append(b, '\n'), 0644)
+}
+
+// Unreplayed returns the recorded interactions that haven't been replayed yet.
+// Tests can use it to make sure all the expected requests were made.
+// It always returns nil in ModeRecord.
+func (r *Recorder) Unreplayed() []*Interaction {
+	if r.mode != ModeReplay {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var interactions []*Interaction
+	for i, interaction := range r.cassette.Interactions {
+		if !r.replayed[i] {
+			interactions = append(interactions, interaction)
+		}
+	}
+	return interactions
+}
+
+// readRequestBody reads and closes the body of the request.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	return body, err
+}
+
+func newRequest(req *http.Request, body []byte) *Request {
+	return &Request{
+		Method: req.Method,
+		URL:    redactURL(req.URL),
+		Header: redactHeader(req.Header),
+		Body:   redactBody(req.Header.Get("Content-Type"), body),
+	}
+}
+
+func newResponse(resp *http.Response, body []byte) *Response {
+	header := redactHeader(resp.Header)
+	// the body might change size once redacted
+	header.Del("Content-Length")
+
+	return &Response{
+		StatusCode: resp.StatusCode,
+		Header:     header,
+		Body:       redactBody(resp.Header.Get("Content-Type"), body),
+	}
+}
+
+// matches reports whether the requests are the same, ignoring their headers.
+func (r *Request) matches(other *Request) bool {
+	return r.Method == other.Method && r.URL == other.URL && r.Body == other.Body
+}
+
+func (r *Response) httpResponse(req *http.Request) *http.Response {
+	header := r.Header.Clone()
+	if header == nil {
+		header = make(http.Header)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", r.StatusCode, http.StatusText(r.StatusCode)),
+		StatusCode:    r.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(strings.NewReader(r.Body)),
+		ContentLength: int64(len(r.Body)),
+		Request:       req,
+	}
+}
+
+func isSecretField(name string) bool {
+	for _, field := range secretFields {
+		if strings.EqualFold(name, field) {
+			return true
+		}
+	}
+	return false
+}
+
+func redactHeader(header http.Header) http.Header {
+	header = header.Clone()
+	for _, h := range secretHeaders {
+		header.Del(h)
+	}
+	return header
+}
+
+// redactURL returns the URL with the secrets in its query redacted, and its query sorted.
+func redactURL(u *url.URL) string {
+	redacted := *u
+	redacted.User = nil
+	redacted.RawQuery = redactValues(u.Query()).Encode()
+	return redacted.String()
+}
+
+func redactValues(values url.Values) url.Values {
+	for k := range values {
+		if isSecretField(k) {
+			values[k] = []string{Redacted}
+		}
+	}
+	return values
+}
+
+// redactBody redacts the secrets in form and JSON bodies.
+// Form bodies are also sorted, so that they can be compared.
+func redactBody(contentType string, body []byte) string {
+	switch {
+	case len(body) == 0:
+		return ""
+	case strings.HasPrefix(contentType, "application/x-www-form-urlencoded"):
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return string(body)
+		}
+		return redactValues(values).Encode()
+	case strings.HasPrefix(contentType, "application/json"):
+		var v interface{}
+		if err := json.Unmarshal(body, &v); err != nil {
+			return string(body)
+		}
+		if !redactJSON(v) {
+			return string(body)
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			return string(body)
+		}
+		return string(b)
+	default:
+		return string(body)
+	}
+}
+
+// redactJSON redacts the secret fields of the JSON value, and reports whether it found any.
+func redactJSON(v interface{}) bool {
+	redacted := false
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, item := range v {
+			if _, ok := item.(string); ok && isSecretField(k) {
+				v[k] = Redacted
+				redacted = true
+				continue
+			}
+			if redactJSON(item) {
+				redacted = true
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			if redactJSON(item) {
+				redacted = true
+			}
+		}
+	}
+
+	return redacted
+}
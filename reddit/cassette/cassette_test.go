@@ -0,0 +1,117 @@
+package cassette
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vartanbeno/go-reddit/reddit"
+)
+
+var ctx = context.Background()
+
+func newClient(t *testing.T, httpClient *http.Client, serverURL string) *reddit.Client {
+	client, err := reddit.NewClient(httpClient,
+		&reddit.Credentials{ID: "id1", Secret: "secret1", Username: "user1", Password: "password1"},
+		reddit.WithBaseURL(serverURL),
+		reddit.WithTokenURL(serverURL+"/api/v1/access_token"),
+	)
+	require.NoError(t, err)
+	return client
+}
+
+func TestRecorder(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+
+	mux.HandleFunc("/api/v1/access_token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "password1", r.Form.Get("password"))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=secret")
+		fmt.Fprint(w, `{"access_token":"token1","token_type":"bearer","expires_in":3600,"scope":"*"}`)
+	})
+	mux.HandleFunc("/r/test/about", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "Bearer token1", r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"kind":"t5","data":{"display_name":"test","subscribers":8202}}`)
+	})
+
+	dir, err := ioutil.TempDir("", "cassette")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "cassettes", "subreddit.json")
+
+	recorder, err := New(path, ModeRecord, nil)
+	require.NoError(t, err)
+
+	client := newClient(t, recorder.Client(), server.URL)
+	subreddit, _, err := client.Subreddit.Get(ctx, "test")
+	require.NoError(t, err)
+	require.Equal(t, 8202, subreddit.Subscribers)
+	require.Nil(t, recorder.Unreplayed())
+
+	require.NoError(t, recorder.Save())
+	server.Close()
+
+	b, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	for _, secret := range []string{"secret1", "password1", "token1", "session=secret"} {
+		require.NotContains(t, string(b), secret)
+	}
+
+	recorder, err = New(path, ModeReplay, nil)
+	require.NoError(t, err)
+	require.Len(t, recorder.Unreplayed(), 2)
+
+	client = newClient(t, recorder.Client(), server.URL)
+	subreddit, _, err = client.Subreddit.Get(ctx, "test")
+	require.NoError(t, err)
+	require.Equal(t, "test", subreddit.Name)
+	require.Equal(t, 8202, subreddit.Subscribers)
+	require.Empty(t, recorder.Unreplayed())
+
+	// each interaction is only replayed once
+	_, _, err = client.Subreddit.Get(ctx, "test")
+	require.True(t, errors.Is(err, ErrNoMatch))
+}
+
+func TestRecorder_NoMatch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cassette")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "empty.json")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`{"interactions":[]}`), 0644))
+
+	recorder, err := New(path, ModeReplay, nil)
+	require.NoError(t, err)
+
+	_, err = recorder.Client().Get("https://oauth.reddit.com/r/test/about")
+	require.True(t, errors.Is(err, ErrNoMatch))
+	require.Contains(t, err.Error(), "GET https://oauth.reddit.com/r/test/about")
+}
+
+func TestRedactBody(t *testing.T) {
+	require.Equal(t,
+		"grant_type=password&password=REDACTED&username=user1",
+		redactBody("application/x-www-form-urlencoded", []byte("username=user1&password=password1&grant_type=password")),
+	)
+	require.Equal(t,
+		`{"access_token":"REDACTED","data":[{"refresh_token":"REDACTED"}]}`,
+		redactBody("application/json; charset=UTF-8", []byte(`{"access_token":"token1","data":[{"refresh_token":"token2"}]}`)),
+	)
+	require.Equal(t,
+		`{"kind": "t5"}`,
+		redactBody("application/json", []byte(`{"kind": "t5"}`)),
+	)
+}
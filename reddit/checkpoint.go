@@ -0,0 +1,116 @@
+package reddit
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+)
+
+// Checkpoint is the position of a stream, i.e. the newest item received from it.
+type Checkpoint struct {
+	// Full ID of the item.
+	ID string `json:"id"`
+	// Time the item was created, or the zero time if it's unknown.
+	// If the item is no longer listed when the stream resumes, e.g. because it was removed,
+	// the items created until then are the ones considered received.
+	Created time.Time `json:"created_utc"`
+}
+
+// Checkpointer persists the position of streams, so that a restarted stream resumes
+// where it left off instead of missing or repeating items. See StreamCheckpoint.
+type Checkpointer interface {
+	// LoadCheckpoint returns the checkpoint of the stream, or the zero value if there isn't one.
+	LoadCheckpoint(stream string) (Checkpoint, error)
+	// SaveCheckpoint records the checkpoint of the stream.
+	SaveCheckpoint(stream string, checkpoint Checkpoint) error
+}
+
+// MemoryCheckpointer stores checkpoints in memory.
+// It can be shared by multiple streams within the same process.
+type MemoryCheckpointer struct {
+	mu          sync.Mutex
+	checkpoints map[string]Checkpoint
+}
+
+// LoadCheckpoint returns the checkpoint of the stream, or the zero value if there isn't one.
+func (c *MemoryCheckpointer) LoadCheckpoint(stream string) (Checkpoint, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.checkpoints[stream], nil
+}
+
+// SaveCheckpoint stores the checkpoint of the stream, replacing the existing one (if any).
+func (c *MemoryCheckpointer) SaveCheckpoint(stream string, checkpoint Checkpoint) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.checkpoints == nil {
+		c.checkpoints = make(map[string]Checkpoint)
+	}
+	c.checkpoints[stream] = checkpoint
+	return nil
+}
+
+// FileCheckpointer stores the checkpoints of streams as a JSON object in a file.
+// It can be shared by multiple streams within the same process, and the file is replaced
+// atomically on every save, so it's never left partially written.
+type FileCheckpointer struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileCheckpointer returns a checkpointer that keeps the checkpoints in the file at path.
+// The file is created on the first save if it doesn't exist.
+func NewFileCheckpointer(path string) *FileCheckpointer {
+	return &FileCheckpointer{path: path}
+}
+
+// LoadCheckpoint returns the checkpoint of the stream, or the zero value if there isn't one.
+func (c *FileCheckpointer) LoadCheckpoint(stream string) (Checkpoint, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	checkpoints, err := c.load()
+	if err != nil {
+		return Checkpoint{}, err
+	}
+	return checkpoints[stream], nil
+}
+
+// SaveCheckpoint stores the checkpoint of the stream, replacing the existing one (if any).
+func (c *FileCheckpointer) SaveCheckpoint(stream string, checkpoint Checkpoint) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	checkpoints, err := c.load()
+	if err != nil {
+		return err
+	}
+	checkpoints[stream] = checkpoint
+
+	data, err := json.Marshal(checkpoints)
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(c.path, data)
+}
+
+func (c *FileCheckpointer) load() (map[string]Checkpoint, error) {
+	checkpoints := make(map[string]Checkpoint)
+
+	data, err := ioutil.ReadFile(c.path)
+	if os.IsNotExist(err) {
+		return checkpoints, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &checkpoints); err != nil {
+		return nil, err
+	}
+	return checkpoints, nil
+}
@@ -0,0 +1,69 @@
+package reddit
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryCheckpointer(t *testing.T) {
+	created := time.Date(2020, 8, 1, 12, 0, 0, 0, time.UTC)
+	checkpointer := new(MemoryCheckpointer)
+
+	checkpoint, err := checkpointer.LoadCheckpoint("r/test/new")
+	require.NoError(t, err)
+	require.Zero(t, checkpoint)
+
+	require.NoError(t, checkpointer.SaveCheckpoint("r/test/new", Checkpoint{ID: "t3_post1"}))
+	require.NoError(t, checkpointer.SaveCheckpoint("r/test/comments", Checkpoint{ID: "t1_comment1"}))
+	require.NoError(t, checkpointer.SaveCheckpoint("r/test/new", Checkpoint{ID: "t3_post2", Created: created}))
+
+	checkpoint, err = checkpointer.LoadCheckpoint("r/test/new")
+	require.NoError(t, err)
+	require.Equal(t, Checkpoint{ID: "t3_post2", Created: created}, checkpoint)
+
+	checkpoint, err = checkpointer.LoadCheckpoint("r/test/comments")
+	require.NoError(t, err)
+	require.Equal(t, Checkpoint{ID: "t1_comment1"}, checkpoint)
+}
+
+func TestFileCheckpointer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "go-reddit")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	created := time.Date(2020, 8, 1, 12, 0, 0, 0, time.UTC)
+	path := filepath.Join(dir, "checkpoints.json")
+	checkpointer := NewFileCheckpointer(path)
+
+	checkpoint, err := checkpointer.LoadCheckpoint("r/test/new")
+	require.NoError(t, err)
+	require.Zero(t, checkpoint)
+
+	require.NoError(t, checkpointer.SaveCheckpoint("r/test/new", Checkpoint{ID: "t3_post1"}))
+	require.NoError(t, checkpointer.SaveCheckpoint("r/test/comments", Checkpoint{ID: "t1_comment1"}))
+	require.NoError(t, checkpointer.SaveCheckpoint("r/test/new", Checkpoint{ID: "t3_post2", Created: created}))
+
+	// another checkpointer, e.g. in a restarted process, reads the same checkpoints
+	checkpointer = NewFileCheckpointer(path)
+
+	checkpoint, err = checkpointer.LoadCheckpoint("r/test/new")
+	require.NoError(t, err)
+	require.Equal(t, Checkpoint{ID: "t3_post2", Created: created}, checkpoint)
+
+	checkpoint, err = checkpointer.LoadCheckpoint("r/test/comments")
+	require.NoError(t, err)
+	require.Equal(t, Checkpoint{ID: "t1_comment1"}, checkpoint)
+
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	require.NoError(t, ioutil.WriteFile(path, []byte("{"), 0600))
+	_, err = checkpointer.LoadCheckpoint("r/test/new")
+	require.Error(t, err)
+}
@@ -0,0 +1,111 @@
+package reddit
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DryRunRequest is a mutating request that a client in dry-run mode didn't send.
+type DryRunRequest struct {
+	// Service call the request was made for. See CallInfo for more information.
+	Operation string
+	Endpoint  string
+
+	Method string
+	URL    string
+	// Form values of the request, if it has a form body, with the values of secrets replaced by AuditRedacted.
+	Form url.Values
+	// Body of the request, if it doesn't have a form body.
+	Body string
+
+	Time time.Time
+}
+
+// DryRunLog keeps the requests that a client in dry-run mode didn't send, in memory.
+// It is safe for concurrent use.
+type DryRunLog struct {
+	mu       sync.Mutex
+	requests []*DryRunRequest
+}
+
+// Record adds the request to the log. Pass it to WithDryRun.
+func (l *DryRunLog) Record(req *DryRunRequest) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.requests = append(l.requests, req)
+}
+
+// Requests returns the requests in the log, in the order they were made.
+func (l *DryRunLog) Requests() []*DryRunRequest {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]*DryRunRequest(nil), l.requests...)
+}
+
+// readOnlyEndpoints are endpoints that are requested with POST, but only read data.
+var readOnlyEndpoints = map[string]bool{
+	"api/morechildren": true,
+}
+
+// isMutating reports whether requests with the method to the endpoint modify data on Reddit.
+func isMutating(method, endpoint string) bool {
+	if readOnlyEndpoints[endpoint] {
+		return false
+	}
+
+	switch method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// dryRunResponse records the request instead of sending it, and returns a synthetic successful response to it.
+func (c *Client) dryRunResponse(req *http.Request, info *CallInfo) (*http.Response, error) {
+	dryRunReq := &DryRunRequest{
+		Method: req.Method,
+		URL:    req.URL.String(),
+		Time:   time.Now(),
+	}
+	if info != nil {
+		dryRunReq.Operation = info.Operation
+		dryRunReq.Endpoint = info.Endpoint
+	}
+
+	if req.Body != nil {
+		body, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		if strings.HasPrefix(req.Header.Get(headerContentType), mediaTypeForm) {
+			form, err := url.ParseQuery(string(body))
+			if err != nil {
+				return nil, err
+			}
+			dryRunReq.Form = redactParams(form)
+		} else if len(body) > 0 {
+			dryRunReq.Body = string(body)
+		}
+	}
+
+	c.dryRun(dryRunReq)
+
+	return &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     make(http.Header),
+		Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+		Request:    req,
+	}, nil
+}
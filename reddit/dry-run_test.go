@@ -0,0 +1,107 @@
+package reddit
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDryRun(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	log := new(DryRunLog)
+	require.NoError(t, WithDryRun(log.Record)(client))
+
+	mux.HandleFunc("/r/test/about", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, `{"kind":"t5","data":{"display_name":"test"}}`)
+	})
+	mux.HandleFunc("/api/remove", func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("mutating request was sent")
+	})
+	mux.HandleFunc("/api/submit", func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("mutating request was sent")
+	})
+
+	subreddit, _, err := client.Subreddit.Get(ctx, "test")
+	require.NoError(t, err)
+	require.Equal(t, "test", subreddit.Name)
+	require.Empty(t, log.Requests())
+
+	resp, err := client.Moderation.Remove(ctx, "t3_test")
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	submitted, _, err := client.Post.SubmitText(ctx, SubmitTextOptions{Subreddit: "test", Title: "title"})
+	require.NoError(t, err)
+	require.Nil(t, submitted)
+
+	requests := log.Requests()
+	require.Len(t, requests, 2)
+
+	require.Equal(t, "Moderation.Remove", requests[0].Operation)
+	require.Equal(t, "api/remove", requests[0].Endpoint)
+	require.Equal(t, http.MethodPost, requests[0].Method)
+	require.Equal(t, client.BaseURL.String()+"/api/remove", requests[0].URL)
+	require.Equal(t, url.Values{"id": {"t3_test"}, "spam": {"false"}}, requests[0].Form)
+	require.False(t, requests[0].Time.IsZero())
+
+	require.Equal(t, "Post.SubmitText", requests[1].Operation)
+	require.Equal(t, "test", requests[1].Form.Get("sr"))
+	require.Equal(t, "self", requests[1].Form.Get("kind"))
+}
+
+func TestDryRun_RevokeToken(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	log := new(DryRunLog)
+	require.NoError(t, WithDryRun(log.Record)(client))
+
+	mux.HandleFunc("/api/v1/revoke_token", func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("mutating request was sent")
+	})
+
+	_, err := client.Account.RevokeAccessToken(ctx, "token1")
+	require.NoError(t, err)
+
+	requests := log.Requests()
+	require.Len(t, requests, 1)
+	require.Equal(t, "Account.RevokeAccessToken", requests[0].Operation)
+	require.Equal(t, url.Values{"token": {AuditRedacted}, "token_type_hint": {"access_token"}}, requests[0].Form)
+}
+
+func TestDryRun_ReadOnlyPost(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	log := new(DryRunLog)
+	require.NoError(t, WithDryRun(log.Record)(client))
+
+	blob, err := readFileContents("../testdata/comment/more.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/morechildren", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	comment := &Comment{
+		FullID: "t1_abc",
+		PostID: "t3_123",
+		Replies: Replies{
+			More: &More{
+				Children: []string{"def", "ghi", "jkl"},
+			},
+		},
+	}
+
+	_, err = client.Comment.LoadMoreReplies(ctx, comment)
+	require.NoError(t, err)
+	require.NotEmpty(t, comment.Replies.Comments)
+	require.Empty(t, log.Requests())
+}
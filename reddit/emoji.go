@@ -210,6 +210,11 @@ func (s *EmojiService) Upload(ctx context.Context, subreddit string, createReque
 		return nil, err
 	}
 
+	// there's no upload lease to upload the image with in dry runs, so only the creation of the emoji is recorded
+	if s.client.dryRun != nil {
+		return s.upload(ctx, subreddit, createRequest, "")
+	}
+
 	uploadURL, fields, resp, err := s.lease(ctx, subreddit, imagePath)
 	if err != nil {
 		return resp, err
@@ -252,7 +257,7 @@ func (s *EmojiService) Upload(ctx context.Context, subreddit string, createReque
 
 	err = CheckResponse(httpResponse)
 	if err != nil {
-		return &Response{httpResponse}, err
+		return newResponse(httpResponse), err
 	}
 
 	return s.upload(ctx, subreddit, createRequest, fields["key"])
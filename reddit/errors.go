@@ -2,10 +2,50 @@ package reddit
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 )
 
+// Sentinel errors for common failures, which can be checked for using errors.Is.
+var (
+	// ErrUnauthorized occurs when the request could not be authenticated (401).
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrForbidden occurs when you aren't allowed to access the resource (403).
+	ErrForbidden = errors.New("forbidden")
+	// ErrNotFound occurs when the resource does not exist (404).
+	ErrNotFound = errors.New("not found")
+	// ErrRateLimited occurs when the client has sent too many requests (429).
+	ErrRateLimited = errors.New("rate limited")
+	// ErrSubredditPrivate occurs when trying to access a private subreddit you aren't a member of.
+	ErrSubredditPrivate = errors.New("subreddit is private")
+	// ErrSubredditQuarantined occurs when trying to access a quarantined subreddit you haven't opted into.
+	ErrSubredditQuarantined = errors.New("subreddit is quarantined")
+	// ErrSubredditBanned occurs when trying to access a subreddit that has been banned.
+	ErrSubredditBanned = errors.New("subreddit is banned")
+	// ErrSubmitRateLimited occurs when you're doing an action (e.g. submitting posts or comments) too often.
+	// Reddit tells you how long to wait before trying again, see APIError.RetryAfter.
+	ErrSubmitRateLimited = errors.New("submission rate limited")
+	// ErrNoUserContext occurs when calling a method that acts on behalf of a user
+	// with a client that uses application-only authentication.
+	ErrNoUserContext = errors.New("action requires a user context, but the client uses application-only authentication")
+	// ErrReadOnly occurs when a read-only client tries to do something other than reading data.
+	ErrReadOnly = errors.New("client is read-only")
+	// ErrUnknownScopes occurs when asking for the scopes of an access token that doesn't say which ones it was granted,
+	// e.g. a token provided with WithToken that didn't keep the "scope" field of Reddit's response.
+	ErrUnknownScopes = errors.New("scopes of the access token are unknown")
+)
+
+const apiErrorLabelRateLimit = "RATELIMIT"
+
+// Matches the time to wait in rate limit errors, e.g. "you are doing that too much. try again in 9 minutes."
+var apiErrorRateLimitRegex = regexp.MustCompile(`(\d+) (millisecond|second|minute|hour)s?`)
+
 // APIError is an error coming from Reddit.
 type APIError struct {
 	Label  string
@@ -33,6 +73,41 @@ func (e *APIError) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// Is reports whether the error matches the target sentinel error.
+func (e *APIError) Is(target error) bool {
+	return target == ErrSubmitRateLimited && e.Label == apiErrorLabelRateLimit
+}
+
+// RetryAfter returns how long Reddit wants you to wait before trying again, for RATELIMIT errors.
+// It returns 0 for other errors, or if the duration could not be found in the reason.
+func (e *APIError) RetryAfter() time.Duration {
+	if e.Label != apiErrorLabelRateLimit {
+		return 0
+	}
+
+	match := apiErrorRateLimitRegex.FindStringSubmatch(e.Reason)
+	if match == nil {
+		return 0
+	}
+
+	n, err := strconv.Atoi(match[1])
+	if err != nil {
+		return 0
+	}
+
+	unit := time.Minute
+	switch match[2] {
+	case "millisecond":
+		unit = time.Millisecond
+	case "second":
+		unit = time.Second
+	case "hour":
+		unit = time.Hour
+	}
+
+	return time.Duration(n) * unit
+}
+
 // JSONErrorResponse is an error response that sometimes gets returned with a 200 code.
 type JSONErrorResponse struct {
 	// HTTP response that caused this error.
@@ -44,10 +119,11 @@ type JSONErrorResponse struct {
 }
 
 func (r *JSONErrorResponse) Error() string {
-	var message string
-	if len(r.JSON.Errors) > 0 {
-		message = r.JSON.Errors[0].Error()
+	messages := make([]string, len(r.JSON.Errors))
+	for i, err := range r.JSON.Errors {
+		messages[i] = err.Error()
 	}
+	message := strings.Join(messages, "; ")
 
 	return fmt.Sprintf(
 		"%s %s: %d %s",
@@ -55,6 +131,39 @@ func (r *JSONErrorResponse) Error() string {
 	)
 }
 
+// Is reports whether any of the API errors in the response match the target sentinel error.
+func (r *JSONErrorResponse) Is(target error) bool {
+	for i := range r.JSON.Errors {
+		if r.JSON.Errors[i].Is(target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As sets target to the first API error of the response if target is of type **APIError.
+// To inspect all of them, use the JSON.Errors field.
+func (r *JSONErrorResponse) As(target interface{}) bool {
+	t, ok := target.(**APIError)
+	if !ok || len(r.JSON.Errors) == 0 {
+		return false
+	}
+	*t = &r.JSON.Errors[0]
+	return true
+}
+
+// RetryAfter returns how long Reddit wants you to wait before trying again,
+// if the response contains a RATELIMIT error. Otherwise, it returns 0.
+func (r *JSONErrorResponse) RetryAfter() time.Duration {
+	var d time.Duration
+	for i := range r.JSON.Errors {
+		if v := r.JSON.Errors[i].RetryAfter(); v > d {
+			d = v
+		}
+	}
+	return d
+}
+
 // An ErrorResponse reports the error caused by an API request
 type ErrorResponse struct {
 	// HTTP response that caused this error
@@ -62,6 +171,9 @@ type ErrorResponse struct {
 
 	// Error message
 	Message string `json:"message"`
+
+	// Reason for the error, if Reddit provided one, e.g. "private" or "banned" when accessing a subreddit.
+	Reason string `json:"reason,omitempty"`
 }
 
 func (r *ErrorResponse) Error() string {
@@ -71,4 +183,77 @@ func (r *ErrorResponse) Error() string {
 	)
 }
 
-// todo: rate limit errors
+// Is reports whether the error matches the target sentinel error.
+func (r *ErrorResponse) Is(target error) bool {
+	switch target {
+	case ErrUnauthorized:
+		return r.Response.StatusCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return r.Response.StatusCode == http.StatusForbidden
+	case ErrNotFound:
+		return r.Response.StatusCode == http.StatusNotFound
+	case ErrRateLimited:
+		return r.Response.StatusCode == http.StatusTooManyRequests
+	case ErrSubredditPrivate:
+		return r.Reason == "private"
+	case ErrSubredditQuarantined:
+		return r.Reason == "quarantined"
+	case ErrSubredditBanned:
+		return r.Reason == "banned"
+	default:
+		return false
+	}
+}
+
+// RateLimitError occurs when the client is sending too many requests to Reddit in a given time frame.
+type RateLimitError struct {
+	// Rate specifies the last known rate limit for the client.
+	Rate Rate
+	// HTTP response that caused this error.
+	Response *http.Response
+	// Error message.
+	Message string
+	// Value of the Retry-After header, if Reddit sent one.
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf(
+		"%s %s: %d %s %s",
+		e.Response.Request.Method, e.Response.Request.URL, e.Response.StatusCode, e.Message, e.formatRateReset(time.Until(e.Rate.Reset)),
+	)
+}
+
+// Is reports whether the error matches the target sentinel error.
+func (e *RateLimitError) Is(target error) bool {
+	return target == ErrRateLimited
+}
+
+func (e *RateLimitError) formatRateReset(d time.Duration) string {
+	if e.Rate.Reset.IsZero() {
+		return "[rate limit reset unknown]"
+	}
+
+	isNegative := d < 0
+	if isNegative {
+		d *= -1
+	}
+
+	// the reset is a whole second (see parseRate), so the time until it is rounded up,
+	// like a countdown, to not depend on how far into the current second we are
+	secondsTotal := int(math.Ceil(d.Seconds()))
+	minutes := secondsTotal / 60
+	seconds := secondsTotal - minutes*60
+
+	var timeString string
+	if minutes > 0 {
+		timeString = fmt.Sprintf("%dm%02ds", minutes, seconds)
+	} else {
+		timeString = fmt.Sprintf("%ds", seconds)
+	}
+
+	if isNegative {
+		return fmt.Sprintf("[rate limit was reset %v ago]", timeString)
+	}
+	return fmt.Sprintf("[rate reset in %v]", timeString)
+}
@@ -0,0 +1,114 @@
+package reddit
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestErrorResponse_Is(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/r/private/about", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"reason": "private", "message": "Forbidden", "error": 403}`)
+	})
+
+	mux.HandleFunc("/r/banned/about", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"reason": "banned", "message": "Not Found", "error": 404}`)
+	})
+
+	mux.HandleFunc("/r/unauthorized/about", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"message": "Unauthorized", "error": 401}`)
+	})
+
+	_, _, err := client.Subreddit.Get(ctx, "private")
+	require.True(t, errors.Is(err, ErrForbidden))
+	require.True(t, errors.Is(err, ErrSubredditPrivate))
+	require.False(t, errors.Is(err, ErrNotFound))
+	require.False(t, errors.Is(err, ErrSubredditBanned))
+
+	_, _, err = client.Subreddit.Get(ctx, "banned")
+	require.True(t, errors.Is(err, ErrNotFound))
+	require.True(t, errors.Is(err, ErrSubredditBanned))
+	require.False(t, errors.Is(err, ErrForbidden))
+
+	_, _, err = client.Subreddit.Get(ctx, "unauthorized")
+	require.True(t, errors.Is(err, ErrUnauthorized))
+	require.False(t, errors.Is(err, ErrSubredditPrivate))
+
+	// this one isn't handled by the mux, so it's a regular 404
+	_, _, err = client.Subreddit.Get(ctx, "doesnotexist")
+	require.True(t, errors.Is(err, ErrNotFound))
+	require.False(t, errors.Is(err, ErrSubredditBanned))
+}
+
+func TestRateLimitError_Is(t *testing.T) {
+	err := fmt.Errorf("wrapped: %w", &RateLimitError{})
+	require.True(t, errors.Is(err, ErrRateLimited))
+	require.False(t, errors.Is(err, ErrSubmitRateLimited))
+}
+
+func TestJSONErrorResponse_Is(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/comment", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		fmt.Fprint(w, `{
+			"json": {
+				"errors": [
+					[
+						"TOO_LONG",
+						"this is too long (max: 10000)",
+						"text"
+					],
+					[
+						"RATELIMIT",
+						"Looks like you've been doing that a lot. Take a break for 9 minutes before trying again.",
+						"ratelimit"
+					]
+				]
+			}
+		}`)
+	})
+
+	_, _, err := client.Comment.Submit(ctx, "t3_test", "test comment")
+	require.EqualError(t, err, fmt.Sprintf(`POST %s/api/comment: 200 field "text" caused TOO_LONG: this is too long (max: 10000); field "ratelimit" caused RATELIMIT: Looks like you've been doing that a lot. Take a break for 9 minutes before trying again.`, client.BaseURL))
+	require.True(t, errors.Is(err, ErrSubmitRateLimited))
+	require.False(t, errors.Is(err, ErrRateLimited))
+
+	var jsonErr *JSONErrorResponse
+	require.True(t, errors.As(err, &jsonErr))
+	require.Len(t, jsonErr.JSON.Errors, 2)
+	require.Equal(t, time.Minute*9, jsonErr.RetryAfter())
+
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr))
+	require.Equal(t, "TOO_LONG", apiErr.Label)
+}
+
+func TestAPIError_RetryAfter(t *testing.T) {
+	tests := []struct {
+		err      APIError
+		expected time.Duration
+	}{
+		{APIError{Label: "RATELIMIT", Reason: "you are doing that too much. try again in 9 minutes."}, time.Minute * 9},
+		{APIError{Label: "RATELIMIT", Reason: "you are doing that too much. try again in 1 minute."}, time.Minute},
+		{APIError{Label: "RATELIMIT", Reason: "you are doing that too much. try again in 45 seconds."}, time.Second * 45},
+		{APIError{Label: "RATELIMIT", Reason: "you are doing that too much. try again in 500 milliseconds."}, time.Millisecond * 500},
+		{APIError{Label: "RATELIMIT", Reason: "you are doing that too much."}, 0},
+		{APIError{Label: "TOO_LONG", Reason: "try again in 9 minutes."}, 0},
+	}
+
+	for _, test := range tests {
+		require.Equal(t, test.expected, test.err.RetryAfter(), test.err.Reason)
+	}
+}
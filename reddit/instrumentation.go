@@ -0,0 +1,133 @@
+package reddit
+
+import (
+	"context"
+	"expvar"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Instrumentation gets notified of the API calls made by the client, e.g. to trace them or
+// record metrics about them. Calls should be identified by their CallInfo.Operation, which
+// doesn't contain any IDs or names, unlike the URL of the request.
+type Instrumentation interface {
+	// StartCall is called before a call is made. The returned context is used for the call,
+	// e.g. to carry a span, and the returned function is called once the call has finished,
+	// after all retries.
+	StartCall(ctx context.Context, info *CallInfo) (context.Context, func(*CallStats))
+}
+
+// CallStats holds information about a finished API call.
+type CallStats struct {
+	// Status code of the last response received, or 0 if none was received.
+	StatusCode int
+	// Error the call failed with, if any.
+	Err error
+	// Number of times the request was retried.
+	Retries int
+	// Time it took to make the call, including retries.
+	Duration time.Duration
+	// Rate limit according to the last response received.
+	// It is the zero value if Reddit didn't send one.
+	Rate Rate
+}
+
+// NopInstrumentation is an instrumentation that does nothing.
+type NopInstrumentation struct{}
+
+// StartCall returns the context as is, and a function that does nothing.
+func (NopInstrumentation) StartCall(ctx context.Context, info *CallInfo) (context.Context, func(*CallStats)) {
+	return ctx, func(*CallStats) {}
+}
+
+// ExpvarInstrumentation records metrics about API calls as expvar variables,
+// which are served as JSON at /debug/vars when importing the net/http/pprof
+// or expvar packages. The metrics are published as a map, keyed by operation:
+//
+//	{
+//	  "Subreddit.TopPosts": {
+//	    "calls": 12,
+//	    "errors": 1,
+//	    "retries": 2,
+//	    "duration_ms": 3456,
+//	    "status_200": 11,
+//	    "status_503": 1
+//	  },
+//	  "rate_remaining": 584
+//	}
+//
+// Calls that weren't made through one of the client's services are recorded under "other".
+type ExpvarInstrumentation struct {
+	metrics       *expvar.Map
+	rateRemaining *expvar.Int
+}
+
+// expvarMu guards the creation of the maps published by ExpvarInstrumentation, and of the maps
+// of their operations. Since those can be shared by multiple instances, the mutex is global.
+var expvarMu sync.Mutex
+
+// NewExpvarInstrumentation returns an instrumentation that publishes its metrics under the name.
+// If a map was already published under that name (e.g. by another client), it is reused.
+// An error is returned if a variable that isn't a map was published under that name.
+func NewExpvarInstrumentation(name string) (*ExpvarInstrumentation, error) {
+	expvarMu.Lock()
+	defer expvarMu.Unlock()
+
+	var metrics *expvar.Map
+	switch v := expvar.Get(name).(type) {
+	case nil:
+		metrics = expvar.NewMap(name)
+	case *expvar.Map:
+		metrics = v
+	default:
+		return nil, fmt.Errorf("expvar %q: already published as %T", name, v)
+	}
+
+	rateRemaining, ok := metrics.Get("rate_remaining").(*expvar.Int)
+	if !ok {
+		rateRemaining = new(expvar.Int)
+		metrics.Set("rate_remaining", rateRemaining)
+	}
+
+	return &ExpvarInstrumentation{metrics: metrics, rateRemaining: rateRemaining}, nil
+}
+
+// StartCall returns the context as is, and a function that records the metrics of the call.
+func (i *ExpvarInstrumentation) StartCall(ctx context.Context, info *CallInfo) (context.Context, func(*CallStats)) {
+	operation := info.Operation
+	if operation == "" {
+		operation = "other"
+	}
+
+	return ctx, func(stats *CallStats) {
+		metrics := i.operationMetrics(operation)
+
+		metrics.Add("calls", 1)
+		metrics.Add("retries", int64(stats.Retries))
+		metrics.Add("duration_ms", stats.Duration.Milliseconds())
+		if stats.Err != nil {
+			metrics.Add("errors", 1)
+		}
+		if stats.StatusCode != 0 {
+			metrics.Add("status_"+strconv.Itoa(stats.StatusCode), 1)
+		}
+
+		if !stats.Rate.Reset.IsZero() {
+			i.rateRemaining.Set(int64(stats.Rate.Remaining))
+		}
+	}
+}
+
+func (i *ExpvarInstrumentation) operationMetrics(operation string) *expvar.Map {
+	expvarMu.Lock()
+	defer expvarMu.Unlock()
+
+	metrics, ok := i.metrics.Get(operation).(*expvar.Map)
+	if !ok {
+		metrics = new(expvar.Map).Init()
+		i.metrics.Set(operation, metrics)
+	}
+	return metrics
+}
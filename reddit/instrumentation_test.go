@@ -0,0 +1,188 @@
+package reddit
+
+import (
+	"context"
+	"encoding/json"
+	"expvar"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testInstrumentationKey struct{}
+
+// expvarNames counts the expvar names returned by newExpvarName.
+var expvarNames uint32
+
+// newExpvarName returns a name that hasn't been published yet, since expvar variables
+// can't be unpublished and tests may be run multiple times in the same process.
+func newExpvarName() string {
+	return fmt.Sprintf("go_reddit_test_%d", atomic.AddUint32(&expvarNames, 1))
+}
+
+type testInstrumentation struct {
+	infos []*CallInfo
+	stats []*CallStats
+}
+
+func (i *testInstrumentation) StartCall(ctx context.Context, info *CallInfo) (context.Context, func(*CallStats)) {
+	i.infos = append(i.infos, info)
+	ctx = context.WithValue(ctx, testInstrumentationKey{}, "span")
+	return ctx, func(stats *CallStats) {
+		i.stats = append(i.stats, stats)
+	}
+}
+
+func TestClient_Instrumentation(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	instrumentation := new(testInstrumentation)
+	client.instrumentation = instrumentation
+	client.retryPolicy = &testRetryPolicy
+
+	var counter int
+	mux.HandleFunc("/r/golang/top", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		defer func() { counter++ }()
+
+		if counter == 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		w.Header().Set(headerRateLimitUsed, "2")
+		w.Header().Set(headerRateLimitRemaining, "598.0")
+		w.Header().Set(headerRateLimitReset, "60")
+		fmt.Fprint(w, `{"kind": "Listing", "data": {"children": []}}`)
+	})
+
+	// the context returned by the instrumentation should be used for the call
+	client.interceptors = []Interceptor{
+		func(req *http.Request, info *CallInfo, next RoundTripFunc) (*http.Response, error) {
+			require.Equal(t, "span", req.Context().Value(testInstrumentationKey{}))
+			return next(req)
+		},
+	}
+
+	_, _, err := client.Subreddit.TopPosts(ctx, "golang", nil)
+	require.NoError(t, err)
+
+	require.Len(t, instrumentation.infos, 1)
+	require.Equal(t, &CallInfo{Operation: "Subreddit.TopPosts", Endpoint: "r/golang/top"}, instrumentation.infos[0])
+
+	require.Len(t, instrumentation.stats, 1)
+	stats := instrumentation.stats[0]
+	require.Equal(t, http.StatusOK, stats.StatusCode)
+	require.NoError(t, stats.Err)
+	require.Equal(t, 1, stats.Retries)
+	require.Equal(t, 598, stats.Rate.Remaining)
+	require.True(t, stats.Duration > 0)
+
+	_, _, err = client.Subreddit.Get(ctx, "doesnotexist")
+	require.Error(t, err)
+
+	require.Len(t, instrumentation.stats, 2)
+	stats = instrumentation.stats[1]
+	require.Equal(t, http.StatusNotFound, stats.StatusCode)
+	require.Equal(t, err, stats.Err)
+	require.Equal(t, 0, stats.Retries)
+}
+
+func TestExpvarInstrumentation(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	name := newExpvarName()
+	instrumentation, err := NewExpvarInstrumentation(name)
+	require.NoError(t, err)
+	client.instrumentation = instrumentation
+
+	mux.HandleFunc("/r/golang/top", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerRateLimitUsed, "2")
+		w.Header().Set(headerRateLimitRemaining, "598.0")
+		w.Header().Set(headerRateLimitReset, "60")
+		fmt.Fprint(w, `{"kind": "Listing", "data": {"children": []}}`)
+	})
+
+	_, _, err = client.Subreddit.TopPosts(ctx, "golang", nil)
+	require.NoError(t, err)
+	_, _, err = client.Subreddit.TopPosts(ctx, "golang", nil)
+	require.NoError(t, err)
+	_, _, err = client.Subreddit.Get(ctx, "doesnotexist")
+	require.Error(t, err)
+
+	// creating another one with the same name reuses the published map
+	instrumentation, err = NewExpvarInstrumentation(name)
+	require.NoError(t, err)
+	require.Equal(t, expvar.Get(name), instrumentation.metrics)
+
+	var metrics struct {
+		RateRemaining int `json:"rate_remaining"`
+		TopPosts      struct {
+			Calls     int `json:"calls"`
+			Errors    int `json:"errors"`
+			Retries   int `json:"retries"`
+			Status200 int `json:"status_200"`
+		} `json:"Subreddit.TopPosts"`
+		Get struct {
+			Calls     int `json:"calls"`
+			Errors    int `json:"errors"`
+			Status404 int `json:"status_404"`
+		} `json:"Subreddit.Get"`
+	}
+	err = json.Unmarshal([]byte(instrumentation.metrics.String()), &metrics)
+	require.NoError(t, err)
+
+	require.Equal(t, 598, metrics.RateRemaining)
+	require.Equal(t, 2, metrics.TopPosts.Calls)
+	require.Equal(t, 0, metrics.TopPosts.Errors)
+	require.Equal(t, 0, metrics.TopPosts.Retries)
+	require.Equal(t, 2, metrics.TopPosts.Status200)
+	require.Equal(t, 1, metrics.Get.Calls)
+	require.Equal(t, 1, metrics.Get.Errors)
+	require.Equal(t, 1, metrics.Get.Status404)
+}
+
+func TestExpvarInstrumentation_Shared(t *testing.T) {
+	name := newExpvarName()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		instrumentation, err := NewExpvarInstrumentation(name)
+		require.NoError(t, err)
+
+		for j := 0; j < 50; j++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, finish := instrumentation.StartCall(ctx, &CallInfo{Operation: "Subreddit.Get"})
+				finish(&CallStats{StatusCode: http.StatusOK})
+			}()
+		}
+	}
+	wg.Wait()
+
+	// both instances record the calls in the same map, without overwriting each other's
+	metrics := expvar.Get(name).(*expvar.Map).Get("Subreddit.Get").(*expvar.Map)
+	require.Equal(t, "100", metrics.Get("calls").String())
+}
+
+func TestExpvarInstrumentation_NotMap(t *testing.T) {
+	name := newExpvarName()
+	expvar.NewInt(name)
+
+	_, err := NewExpvarInstrumentation(name)
+	require.EqualError(t, err, fmt.Sprintf("expvar %q: already published as *expvar.Int", name))
+}
+
+func TestNopInstrumentation(t *testing.T) {
+	ctx2, finish := NopInstrumentation{}.StartCall(ctx, &CallInfo{})
+	require.Equal(t, ctx, ctx2)
+	finish(&CallStats{Duration: time.Second})
+}
@@ -0,0 +1,356 @@
+package reddit
+
+import (
+	"context"
+)
+
+// pager fetches the pages of a listing one by one as its items are iterated over,
+// following the listing's "after" anchor. It is the basis of the iterators below.
+type pager struct {
+	fetch func(ctx context.Context, opts *ListOptions) (items []interface{}, after string, resp *Response, err error)
+
+	opts ListOptions
+	max  int
+
+	items   []interface{}
+	current interface{}
+	count   int
+	done    bool
+
+	resp *Response
+	err  error
+}
+
+func newPager(opts *ListOptions, max int) pager {
+	p := pager{max: max}
+	if opts != nil {
+		p.opts = *opts
+	}
+	return p
+}
+
+// Next advances the iterator to the next item, fetching the next page of the listing if needed.
+// It returns false once there are no more items, the maximum number of items has been reached,
+// the context is done, or an error occurred. Use Err to tell these cases apart.
+// Reddit stops returning items past ~1000 of them in most listings, which ends the iteration cleanly.
+func (p *pager) Next(ctx context.Context) bool {
+	if p.err != nil || (p.max > 0 && p.count >= p.max) {
+		return false
+	}
+
+	for len(p.items) == 0 {
+		if p.done {
+			return false
+		}
+
+		if err := ctx.Err(); err != nil {
+			p.err = err
+			return false
+		}
+
+		// no need to fetch more items than needed
+		opts := p.opts
+		if remaining := p.max - p.count; p.max > 0 && (opts.Limit == 0 || opts.Limit > remaining) {
+			opts.Limit = remaining
+		}
+
+		items, after, resp, err := p.fetch(ctx, &opts)
+		p.resp = resp
+		if err != nil {
+			p.err = err
+			return false
+		}
+
+		p.items = items
+		p.opts.After = after
+		p.done = after == "" || len(items) == 0
+	}
+
+	p.current = p.items[0]
+	p.items = p.items[1:]
+	p.count++
+
+	return true
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (p *pager) Err() error {
+	return p.err
+}
+
+// Response returns the response of the last page fetched.
+func (p *pager) Response() *Response {
+	return p.resp
+}
+
+// ListPostsFunc fetches a page of posts, e.g. by calling a service method with the options.
+type ListPostsFunc func(ctx context.Context, opts *ListOptions) (*Posts, *Response, error)
+
+// PostIterator iterates over posts, fetching pages as needed.
+type PostIterator struct {
+	pager
+}
+
+// NewPostIterator returns an iterator over the posts returned by list, starting from the options.
+// If max is greater than 0, the iteration stops after that many posts.
+func NewPostIterator(list ListPostsFunc, opts *ListOptions, max int) *PostIterator {
+	it := &PostIterator{pager: newPager(opts, max)}
+	it.fetch = func(ctx context.Context, opts *ListOptions) ([]interface{}, string, *Response, error) {
+		page, resp, err := list(ctx, opts)
+		if err != nil {
+			return nil, "", resp, err
+		}
+
+		items := make([]interface{}, len(page.Posts))
+		for i, v := range page.Posts {
+			items[i] = v
+		}
+
+		return items, page.After, resp, nil
+	}
+	return it
+}
+
+// Post returns the current post. It should only be called after Next returns true.
+func (it *PostIterator) Post() *Post {
+	v, _ := it.current.(*Post)
+	return v
+}
+
+// ListCommentsFunc fetches a page of comments, e.g. by calling a service method with the options.
+type ListCommentsFunc func(ctx context.Context, opts *ListOptions) (*Comments, *Response, error)
+
+// CommentIterator iterates over comments, fetching pages as needed.
+type CommentIterator struct {
+	pager
+}
+
+// NewCommentIterator returns an iterator over the comments returned by list, starting from the options.
+// If max is greater than 0, the iteration stops after that many comments.
+func NewCommentIterator(list ListCommentsFunc, opts *ListOptions, max int) *CommentIterator {
+	it := &CommentIterator{pager: newPager(opts, max)}
+	it.fetch = func(ctx context.Context, opts *ListOptions) ([]interface{}, string, *Response, error) {
+		page, resp, err := list(ctx, opts)
+		if err != nil {
+			return nil, "", resp, err
+		}
+
+		items := make([]interface{}, len(page.Comments))
+		for i, v := range page.Comments {
+			items[i] = v
+		}
+
+		return items, page.After, resp, nil
+	}
+	return it
+}
+
+// Comment returns the current comment. It should only be called after Next returns true.
+func (it *CommentIterator) Comment() *Comment {
+	v, _ := it.current.(*Comment)
+	return v
+}
+
+// ListSubredditsFunc fetches a page of subreddits, e.g. by calling a service method with the options.
+type ListSubredditsFunc func(ctx context.Context, opts *ListOptions) (*Subreddits, *Response, error)
+
+// SubredditIterator iterates over subreddits, fetching pages as needed.
+type SubredditIterator struct {
+	pager
+}
+
+// NewSubredditIterator returns an iterator over the subreddits returned by list, starting from the options.
+// If max is greater than 0, the iteration stops after that many subreddits.
+func NewSubredditIterator(list ListSubredditsFunc, opts *ListOptions, max int) *SubredditIterator {
+	it := &SubredditIterator{pager: newPager(opts, max)}
+	it.fetch = func(ctx context.Context, opts *ListOptions) ([]interface{}, string, *Response, error) {
+		page, resp, err := list(ctx, opts)
+		if err != nil {
+			return nil, "", resp, err
+		}
+
+		items := make([]interface{}, len(page.Subreddits))
+		for i, v := range page.Subreddits {
+			items[i] = v
+		}
+
+		return items, page.After, resp, nil
+	}
+	return it
+}
+
+// Subreddit returns the current subreddit. It should only be called after Next returns true.
+func (it *SubredditIterator) Subreddit() *Subreddit {
+	v, _ := it.current.(*Subreddit)
+	return v
+}
+
+// ListUsersFunc fetches a page of users, e.g. by calling a service method with the options.
+type ListUsersFunc func(ctx context.Context, opts *ListOptions) (*Users, *Response, error)
+
+// UserIterator iterates over users, fetching pages as needed.
+type UserIterator struct {
+	pager
+}
+
+// NewUserIterator returns an iterator over the users returned by list, starting from the options.
+// If max is greater than 0, the iteration stops after that many users.
+func NewUserIterator(list ListUsersFunc, opts *ListOptions, max int) *UserIterator {
+	it := &UserIterator{pager: newPager(opts, max)}
+	it.fetch = func(ctx context.Context, opts *ListOptions) ([]interface{}, string, *Response, error) {
+		page, resp, err := list(ctx, opts)
+		if err != nil {
+			return nil, "", resp, err
+		}
+
+		items := make([]interface{}, len(page.Users))
+		for i, v := range page.Users {
+			items[i] = v
+		}
+
+		return items, page.After, resp, nil
+	}
+	return it
+}
+
+// User returns the current user. It should only be called after Next returns true.
+func (it *UserIterator) User() *User {
+	v, _ := it.current.(*User)
+	return v
+}
+
+// ListModActionsFunc fetches a page of moderator actions, e.g. by calling a service method with the options.
+type ListModActionsFunc func(ctx context.Context, opts *ListOptions) (*ModActions, *Response, error)
+
+// ModActionIterator iterates over moderator actions, fetching pages as needed.
+type ModActionIterator struct {
+	pager
+}
+
+// NewModActionIterator returns an iterator over the moderator actions returned by list, starting from the options.
+// If max is greater than 0, the iteration stops after that many moderator actions.
+func NewModActionIterator(list ListModActionsFunc, opts *ListOptions, max int) *ModActionIterator {
+	it := &ModActionIterator{pager: newPager(opts, max)}
+	it.fetch = func(ctx context.Context, opts *ListOptions) ([]interface{}, string, *Response, error) {
+		page, resp, err := list(ctx, opts)
+		if err != nil {
+			return nil, "", resp, err
+		}
+
+		items := make([]interface{}, len(page.ModActions))
+		for i, v := range page.ModActions {
+			items[i] = v
+		}
+
+		return items, page.After, resp, nil
+	}
+	return it
+}
+
+// ModAction returns the current moderator action. It should only be called after Next returns true.
+func (it *ModActionIterator) ModAction() *ModAction {
+	v, _ := it.current.(*ModAction)
+	return v
+}
+
+// ListBansFunc fetches a page of bans, e.g. by calling a service method with the options.
+type ListBansFunc func(ctx context.Context, opts *ListOptions) (*Bans, *Response, error)
+
+// BanIterator iterates over bans, fetching pages as needed.
+type BanIterator struct {
+	pager
+}
+
+// NewBanIterator returns an iterator over the bans returned by list, starting from the options.
+// If max is greater than 0, the iteration stops after that many bans.
+func NewBanIterator(list ListBansFunc, opts *ListOptions, max int) *BanIterator {
+	it := &BanIterator{pager: newPager(opts, max)}
+	it.fetch = func(ctx context.Context, opts *ListOptions) ([]interface{}, string, *Response, error) {
+		page, resp, err := list(ctx, opts)
+		if err != nil {
+			return nil, "", resp, err
+		}
+
+		items := make([]interface{}, len(page.Bans))
+		for i, v := range page.Bans {
+			items[i] = v
+		}
+
+		return items, page.After, resp, nil
+	}
+	return it
+}
+
+// Ban returns the current ban. It should only be called after Next returns true.
+func (it *BanIterator) Ban() *Ban {
+	v, _ := it.current.(*Ban)
+	return v
+}
+
+// ListRelationshipsFunc fetches a page of relationships, e.g. by calling a service method with the options.
+type ListRelationshipsFunc func(ctx context.Context, opts *ListOptions) (*Relationships, *Response, error)
+
+// RelationshipIterator iterates over relationships, fetching pages as needed.
+type RelationshipIterator struct {
+	pager
+}
+
+// NewRelationshipIterator returns an iterator over the relationships returned by list, starting from the options.
+// If max is greater than 0, the iteration stops after that many relationships.
+func NewRelationshipIterator(list ListRelationshipsFunc, opts *ListOptions, max int) *RelationshipIterator {
+	it := &RelationshipIterator{pager: newPager(opts, max)}
+	it.fetch = func(ctx context.Context, opts *ListOptions) ([]interface{}, string, *Response, error) {
+		page, resp, err := list(ctx, opts)
+		if err != nil {
+			return nil, "", resp, err
+		}
+
+		items := make([]interface{}, len(page.Relationships))
+		for i, v := range page.Relationships {
+			items[i] = v
+		}
+
+		return items, page.After, resp, nil
+	}
+	return it
+}
+
+// Relationship returns the current relationship. It should only be called after Next returns true.
+func (it *RelationshipIterator) Relationship() *Relationship {
+	v, _ := it.current.(*Relationship)
+	return v
+}
+
+// ListMessagesFunc fetches a page of messages, e.g. by calling a service method with the options.
+type ListMessagesFunc func(ctx context.Context, opts *ListOptions) (*Messages, *Response, error)
+
+// MessageIterator iterates over messages, fetching pages as needed.
+type MessageIterator struct {
+	pager
+}
+
+// NewMessageIterator returns an iterator over the messages returned by list, starting from the options.
+// If max is greater than 0, the iteration stops after that many messages.
+func NewMessageIterator(list ListMessagesFunc, opts *ListOptions, max int) *MessageIterator {
+	it := &MessageIterator{pager: newPager(opts, max)}
+	it.fetch = func(ctx context.Context, opts *ListOptions) ([]interface{}, string, *Response, error) {
+		page, resp, err := list(ctx, opts)
+		if err != nil {
+			return nil, "", resp, err
+		}
+
+		items := make([]interface{}, len(page.Messages))
+		for i, v := range page.Messages {
+			items[i] = v
+		}
+
+		return items, page.After, resp, nil
+	}
+	return it
+}
+
+// Message returns the current message. It should only be called after Next returns true.
+func (it *MessageIterator) Message() *Message {
+	v, _ := it.current.(*Message)
+	return v
+}
@@ -0,0 +1,193 @@
+package reddit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func handlePostPages(t *testing.T, mux *http.ServeMux, path string, pages int, limits *[]string) {
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		*limits = append(*limits, r.URL.Query().Get("limit"))
+
+		// each page has 2 posts, e.g. page 1 has t3_1 and t3_2
+		var page int
+		switch after := r.URL.Query().Get("after"); after {
+		case "":
+			page = 1
+		default:
+			_, err := fmt.Sscanf(after, "t3_%d", &page)
+			require.NoError(t, err)
+			page = page/2 + 1
+		}
+
+		after := fmt.Sprintf("t3_%d", page*2)
+		if page == pages {
+			after = ""
+		}
+
+		fmt.Fprintf(w, `{
+			"kind": "Listing",
+			"data": {
+				"after": %q,
+				"children": [
+					{"kind": "t3", "data": {"name": "t3_%d"}},
+					{"kind": "t3", "data": {"name": "t3_%d"}}
+				]
+			}
+		}`, after, page*2-1, page*2)
+	})
+}
+
+func TestPostIterator(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var limits []string
+	handlePostPages(t, mux, "/r/golang/top", 3, &limits)
+
+	it := NewPostIterator(func(ctx context.Context, opts *ListOptions) (*Posts, *Response, error) {
+		return client.Subreddit.TopPosts(ctx, "golang", &ListPostOptions{ListOptions: *opts, Time: "all"})
+	}, &ListOptions{Limit: 2}, 0)
+
+	var ids []string
+	for it.Next(ctx) {
+		ids = append(ids, it.Post().FullID)
+	}
+
+	require.NoError(t, it.Err())
+	require.Equal(t, []string{"t3_1", "t3_2", "t3_3", "t3_4", "t3_5", "t3_6"}, ids)
+	require.Equal(t, []string{"2", "2", "2"}, limits)
+	require.Equal(t, http.StatusOK, it.Response().StatusCode)
+	require.False(t, it.Next(ctx))
+}
+
+func TestPostIterator_Max(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var limits []string
+	handlePostPages(t, mux, "/r/golang/new", 10, &limits)
+
+	it := NewPostIterator(func(ctx context.Context, opts *ListOptions) (*Posts, *Response, error) {
+		return client.Subreddit.NewPosts(ctx, "golang", opts)
+	}, nil, 3)
+
+	var ids []string
+	for it.Next(ctx) {
+		ids = append(ids, it.Post().FullID)
+	}
+
+	require.NoError(t, it.Err())
+	require.Equal(t, []string{"t3_1", "t3_2", "t3_3"}, ids)
+	// it should only ask for as many posts as needed
+	require.Equal(t, []string{"3", "1"}, limits)
+}
+
+func TestPostIterator_Error(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/r/golang/new", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	it := NewPostIterator(func(ctx context.Context, opts *ListOptions) (*Posts, *Response, error) {
+		return client.Subreddit.NewPosts(ctx, "golang", opts)
+	}, nil, 0)
+
+	require.False(t, it.Next(ctx))
+	require.IsType(t, &ErrorResponse{}, it.Err())
+	require.Equal(t, http.StatusForbidden, it.Response().StatusCode)
+	require.Nil(t, it.Post())
+}
+
+func TestPostIterator_ContextCancelled(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var limits []string
+	handlePostPages(t, mux, "/r/golang/new", 10, &limits)
+
+	it := NewPostIterator(func(ctx context.Context, opts *ListOptions) (*Posts, *Response, error) {
+		return client.Subreddit.NewPosts(ctx, "golang", opts)
+	}, nil, 0)
+
+	ctx2, cancel := context.WithCancel(ctx)
+
+	require.True(t, it.Next(ctx2))
+	require.True(t, it.Next(ctx2))
+	cancel()
+	require.False(t, it.Next(ctx2))
+	require.Equal(t, context.Canceled, it.Err())
+	require.Len(t, limits, 1)
+}
+
+func TestCommentIterator(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/user/user1/comments", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		switch r.URL.Query().Get("after") {
+		case "":
+			fmt.Fprint(w, `{
+				"kind": "Listing",
+				"data": {
+					"after": "t1_1",
+					"children": [{"kind": "t1", "data": {"name": "t1_1"}}]
+				}
+			}`)
+		case "t1_1":
+			fmt.Fprint(w, `{
+				"kind": "Listing",
+				"data": {
+					"after": "t1_2",
+					"children": [{"kind": "t1", "data": {"name": "t1_2"}}]
+				}
+			}`)
+		default:
+			// this is what Reddit does past the end of a listing
+			fmt.Fprint(w, `{"kind": "Listing", "data": {"after": null, "children": []}}`)
+		}
+	})
+
+	it := NewCommentIterator(func(ctx context.Context, opts *ListOptions) (*Comments, *Response, error) {
+		return client.User.CommentsOf(ctx, "user1", &ListUserOverviewOptions{ListOptions: *opts})
+	}, nil, 0)
+
+	var ids []string
+	for it.Next(ctx) {
+		ids = append(ids, it.Comment().FullID)
+	}
+
+	require.NoError(t, it.Err())
+	require.Equal(t, []string{"t1_1", "t1_2"}, ids)
+}
+
+func TestModActionIterator(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/moderation/actions.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/testsubreddit/about/log", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		fmt.Fprint(w, blob)
+	})
+
+	it := NewModActionIterator(func(ctx context.Context, opts *ListOptions) (*ModActions, *Response, error) {
+		return client.Moderation.GetActions(ctx, "testsubreddit", &ListModActionOptions{ListOptions: *opts})
+	}, nil, 1)
+
+	require.True(t, it.Next(ctx))
+	require.NotNil(t, it.ModAction())
+	require.False(t, it.Next(ctx))
+	require.NoError(t, it.Err())
+}
@@ -4,7 +4,17 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
+	"sort"
 	"strings"
+	"sync"
+)
+
+const (
+	// Maximum number of full IDs Reddit accepts in a single request.
+	maxIDsPerRequest = 100
+	// Maximum number of requests sent at the same time when getting things in batches.
+	maxConcurrentBatchRequests = 4
 )
 
 // ListingsService handles communication with the listing
@@ -15,32 +25,117 @@ type ListingsService struct {
 	client *Client
 }
 
+// BatchError occurs when some of the requests made to get things in batches failed.
+// The things from the requests that succeeded are still returned alongside it.
+type BatchError struct {
+	// Errors of the failed batches, in the order of their IDs.
+	Errors []*BatchItemError
+	// Total number of batches.
+	Batches int
+}
+
+// BatchItemError is the error of a single batch.
+type BatchItemError struct {
+	// Full IDs of the things in the batch.
+	IDs []string
+	Err error
+}
+
+func (e *BatchError) Error() string {
+	if len(e.Errors) == 0 {
+		return fmt.Sprintf("0 of %d batches failed", e.Batches)
+	}
+	return fmt.Sprintf("%d of %d batches failed, first error: %v", len(e.Errors), e.Batches, e.Errors[0].Err)
+}
+
+// Unwrap returns the error of the first failed batch, or nil if there isn't one.
+func (e *BatchError) Unwrap() error {
+	if len(e.Errors) == 0 {
+		return nil
+	}
+	return e.Errors[0].Err
+}
+
 // Get returns posts, comments, and subreddits from their full IDs.
+// Reddit only returns up to 100 things per request, so if more IDs are provided, they are split
+// into batches of 100 which are fetched concurrently. The things are returned in the order of
+// their IDs. If some of the batches fail, the things from the other ones are returned along
+// with a *BatchError.
 func (s *ListingsService) Get(ctx context.Context, ids ...string) ([]*Post, []*Comment, []*Subreddit, *Response, error) {
-	path := fmt.Sprintf("api/info?id=%s", strings.Join(ids, ","))
+	t, resp, err := s.getInBatches(ctx, ids, func(ids []string) string {
+		return fmt.Sprintf("api/info?id=%s", strings.Join(ids, ","))
+	})
+	if t == nil {
+		return nil, nil, nil, resp, err
+	}
+
+	return t.Posts, t.Comments, t.Subreddits, resp, err
+}
+
+// GetPosts returns posts from their full IDs.
+// Reddit only returns up to 100 posts per request, so if more IDs are provided, they are split
+// into batches of 100 which are fetched concurrently. The posts are returned in the order of
+// their IDs. If some of the batches fail, the posts from the other ones are returned along
+// with a *BatchError.
+func (s *ListingsService) GetPosts(ctx context.Context, ids ...string) ([]*Post, *Response, error) {
+	t, resp, err := s.getInBatches(ctx, ids, func(ids []string) string {
+		return fmt.Sprintf("by_id/%s", strings.Join(ids, ","))
+	})
+	if t == nil {
+		return nil, resp, err
+	}
+
+	return t.Posts, resp, err
+}
+
+// GetPostsByURL returns the posts that link to the URL, across all of Reddit.
+// Use the after/before anchors of the result to get subsequent pages.
+func (s *ListingsService) GetPostsByURL(ctx context.Context, link string, opts *ListOptions) (*Posts, *Response, error) {
+	params := url.Values{}
+	params.Set("url", link)
+
+	path, err := addOptions("api/info?"+params.Encode(), opts)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	req, err := s.client.NewRequest(http.MethodGet, path, nil)
 	if err != nil {
-		return nil, nil, nil, nil, err
+		return nil, nil, err
 	}
 
 	root := new(rootListing)
 	resp, err := s.client.Do(ctx, req, root)
 	if err != nil {
-		return nil, nil, nil, resp, err
+		return nil, resp, err
 	}
 
-	posts := root.getPosts().Posts
-	comments := root.getComments().Comments
-	subreddits := root.getSubreddits().Subreddits
-
-	return posts, comments, subreddits, resp, nil
+	return root.getPosts(), resp, nil
 }
 
-// GetPosts returns posts from their full IDs.
-func (s *ListingsService) GetPosts(ctx context.Context, ids ...string) ([]*Post, *Response, error) {
-	path := fmt.Sprintf("by_id/%s", strings.Join(ids, ","))
+// GetSubredditsByName returns subreddits from their names.
+// The subreddits are returned in the order of their names.
+func (s *ListingsService) GetSubredditsByName(ctx context.Context, names ...string) ([]*Subreddit, *Response, error) {
+	params := url.Values{}
+	params.Set("sr_name", strings.Join(names, ","))
+
+	t, resp, err := s.get(ctx, "api/info?"+params.Encode())
+	if err != nil {
+		return nil, resp, err
+	}
+
+	index := make(map[string]int, len(names))
+	for i, name := range names {
+		index[strings.ToLower(name)] = i
+	}
+	sort.SliceStable(t.Subreddits, func(i, j int) bool {
+		return index[strings.ToLower(t.Subreddits[i].Name)] < index[strings.ToLower(t.Subreddits[j].Name)]
+	})
 
+	return t.Subreddits, resp, nil
+}
+
+func (s *ListingsService) get(ctx context.Context, path string) (*things, *Response, error) {
 	req, err := s.client.NewRequest(http.MethodGet, path, nil)
 	if err != nil {
 		return nil, nil, err
@@ -52,6 +147,92 @@ func (s *ListingsService) GetPosts(ctx context.Context, ids ...string) ([]*Post,
 		return nil, resp, err
 	}
 
-	posts := root.getPosts().Posts
-	return posts, resp, nil
+	return &root.Data.Things, resp, nil
+}
+
+// getInBatches gets the things in batches of 100 IDs and merges them in the order of the IDs.
+// The response returned is the one of the first batch.
+func (s *ListingsService) getInBatches(ctx context.Context, ids []string, path func(ids []string) string) (*things, *Response, error) {
+	if len(ids) <= maxIDsPerRequest {
+		t, resp, err := s.get(ctx, path(ids))
+		if err != nil {
+			return nil, resp, err
+		}
+		t.sortByIDs(ids)
+		return t, resp, nil
+	}
+
+	var batches [][]string
+	for i := 0; i < len(ids); i += maxIDsPerRequest {
+		end := i + maxIDsPerRequest
+		if end > len(ids) {
+			end = len(ids)
+		}
+		batches = append(batches, ids[i:end])
+	}
+
+	type result struct {
+		things *things
+		resp   *Response
+		err    error
+	}
+	results := make([]result, len(batches))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, maxConcurrentBatchRequests)
+
+	for i, batch := range batches {
+		wg.Add(1)
+		go func(i int, batch []string) {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			t, resp, err := s.get(ctx, path(batch))
+			results[i] = result{t, resp, err}
+		}(i, batch)
+	}
+	wg.Wait()
+
+	merged := new(things)
+	merged.init()
+
+	batchErr := &BatchError{Batches: len(batches)}
+	for i, result := range results {
+		if result.err != nil {
+			batchErr.Errors = append(batchErr.Errors, &BatchItemError{IDs: batches[i], Err: result.err})
+			continue
+		}
+		merged.Posts = append(merged.Posts, result.things.Posts...)
+		merged.Comments = append(merged.Comments, result.things.Comments...)
+		merged.Subreddits = append(merged.Subreddits, result.things.Subreddits...)
+	}
+	merged.sortByIDs(ids)
+
+	resp := results[0].resp
+	if len(batchErr.Errors) > 0 {
+		return merged, resp, batchErr
+	}
+	return merged, resp, nil
+}
+
+// sortByIDs sorts the posts, comments, and subreddits in the order of their full IDs.
+func (t *things) sortByIDs(ids []string) {
+	index := make(map[string]int, len(ids))
+	for i, id := range ids {
+		if _, ok := index[id]; !ok {
+			index[id] = i
+		}
+	}
+
+	sort.SliceStable(t.Posts, func(i, j int) bool {
+		return index[t.Posts[i].FullID] < index[t.Posts[j].FullID]
+	})
+	sort.SliceStable(t.Comments, func(i, j int) bool {
+		return index[t.Comments[i].FullID] < index[t.Comments[j].FullID]
+	})
+	sort.SliceStable(t.Subreddits, func(i, j int) bool {
+		return index[t.Subreddits[i].FullID] < index[t.Subreddits[j].FullID]
+	})
 }
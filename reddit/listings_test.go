@@ -1,9 +1,12 @@
 package reddit
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -180,3 +183,143 @@ func TestListingsService_GetPosts(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedListingPosts2, posts)
 }
+
+func TestListingsService_GetPosts_Batches(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var ids []string
+	for i := 0; i < 250; i++ {
+		ids = append(ids, fmt.Sprintf("t3_%d", i))
+	}
+
+	var mu sync.Mutex
+	requests := 0
+
+	mux.HandleFunc("/by_id/", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		mu.Lock()
+		requests++
+		mu.Unlock()
+
+		batch := strings.Split(strings.TrimPrefix(r.URL.Path, "/by_id/"), ",")
+		require.LessOrEqual(t, len(batch), 100)
+
+		// return the posts in reverse order to make sure they get sorted
+		var children []string
+		for i := len(batch) - 1; i >= 0; i-- {
+			children = append(children, fmt.Sprintf(`{"kind":"t3","data":{"name":%q}}`, batch[i]))
+		}
+		fmt.Fprintf(w, `{"kind":"Listing","data":{"children":[%s]}}`, strings.Join(children, ","))
+	})
+
+	posts, _, err := client.Listings.GetPosts(ctx, ids...)
+	require.NoError(t, err)
+	require.Equal(t, 3, requests)
+	require.Len(t, posts, len(ids))
+	for i, post := range posts {
+		require.Equal(t, ids[i], post.FullID)
+	}
+}
+
+func TestListingsService_Get_BatchError(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var ids []string
+	for i := 0; i < 150; i++ {
+		ids = append(ids, fmt.Sprintf("t3_%d", i))
+	}
+
+	mux.HandleFunc("/api/info", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		batch := strings.Split(r.URL.Query().Get("id"), ",")
+		if batch[0] == "t3_100" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		var children []string
+		for _, id := range batch {
+			children = append(children, fmt.Sprintf(`{"kind":"t3","data":{"name":%q}}`, id))
+		}
+		fmt.Fprintf(w, `{"kind":"Listing","data":{"children":[%s]}}`, strings.Join(children, ","))
+	})
+
+	posts, comments, subreddits, _, err := client.Listings.Get(ctx, ids...)
+	require.Len(t, posts, 100)
+	require.Empty(t, comments)
+	require.Empty(t, subreddits)
+
+	var batchErr *BatchError
+	require.True(t, errors.As(err, &batchErr))
+	require.Equal(t, 2, batchErr.Batches)
+	require.Len(t, batchErr.Errors, 1)
+	require.Equal(t, ids[100:], batchErr.Errors[0].IDs)
+
+	var errorResponse *ErrorResponse
+	require.True(t, errors.As(err, &errorResponse))
+	require.Equal(t, http.StatusInternalServerError, errorResponse.Response.StatusCode)
+}
+
+func TestBatchError_Empty(t *testing.T) {
+	err := &BatchError{Batches: 2}
+	require.EqualError(t, err, "0 of 2 batches failed")
+	require.NoError(t, err.Unwrap())
+}
+
+func TestListingsService_GetPostsByURL(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/listings/posts.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/api/info", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("url", "http://example.com/?a=1&b=2")
+		form.Set("limit", "2")
+		form.Set("after", "t3_abc")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, blob)
+	})
+
+	posts, _, err := client.Listings.GetPostsByURL(ctx, "http://example.com/?a=1&b=2", &ListOptions{Limit: 2, After: "t3_abc"})
+	require.NoError(t, err)
+	require.Equal(t, &Posts{Posts: expectedListingPosts2}, posts)
+}
+
+func TestListingsService_GetSubredditsByName(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/info", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+
+		form := url.Values{}
+		form.Set("sr_name", "golang,Test")
+
+		err := r.ParseForm()
+		require.NoError(t, err)
+		require.Equal(t, form, r.Form)
+
+		fmt.Fprint(w, `{"kind":"Listing","data":{"children":[
+			{"kind":"t5","data":{"name":"t5_2qh23","display_name":"test"}},
+			{"kind":"t5","data":{"name":"t5_2rc7j","display_name":"golang"}}
+		]}}`)
+	})
+
+	subreddits, _, err := client.Listings.GetSubredditsByName(ctx, "golang", "Test")
+	require.NoError(t, err)
+	require.Len(t, subreddits, 2)
+	require.Equal(t, "golang", subreddits[0].Name)
+	require.Equal(t, "test", subreddits[1].Name)
+}
@@ -58,12 +58,15 @@ type inboxListing struct {
 type inboxThings struct {
 	Comments []*Message
 	Messages []*Message
+	// Both comments and messages, in the order they were listed.
+	All []*Message
 }
 
 // init initializes or clears the inbox.
 func (t *inboxThings) init() {
 	t.Comments = make([]*Message, 0)
 	t.Messages = make([]*Message, 0)
+	t.All = make([]*Message, 0)
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
@@ -81,11 +84,13 @@ func (t *inboxThings) UnmarshalJSON(b []byte) error {
 			v := new(Message)
 			if err := json.Unmarshal(thing.Data, v); err == nil {
 				t.Comments = append(t.Comments, v)
+				t.All = append(t.All, v)
 			}
 		case kindMessage:
 			v := new(Message)
 			if err := json.Unmarshal(thing.Data, v); err == nil {
 				t.Messages = append(t.Messages, v)
+				t.All = append(t.All, v)
 			}
 		}
 	}
@@ -0,0 +1,95 @@
+package reddit
+
+import (
+	"net/http"
+	"reflect"
+	"regexp"
+	"runtime"
+	"strings"
+)
+
+// CallInfo describes the API call a request is made for.
+type CallInfo struct {
+	// Name of the service method that made the call, e.g. "Post.SubmitText" or "Subreddit.TopPosts".
+	// Unlike the URL, it doesn't contain any IDs or names, which makes it suitable for logging and metrics.
+	// It is empty if the request wasn't made through one of the client's services.
+	Operation string
+	// Path of the request relative to the client's base URL, without the query, e.g. "api/submit".
+	Endpoint string
+}
+
+// RoundTripFunc sends a request and returns its response.
+type RoundTripFunc func(req *http.Request) (*http.Response, error)
+
+// Interceptor intercepts the requests sent by the client.
+// It can inspect or modify the request, then call next to send it, and inspect or modify the result.
+// It can also short-circuit the request by returning a response (or an error) without calling next.
+// Interceptors see every attempt of a request, e.g. when it's retried.
+type Interceptor func(req *http.Request, info *CallInfo, next RoundTripFunc) (*http.Response, error)
+
+// chain returns a function that sends the request through the interceptors, in the
+// order they are provided, and then through send.
+func chain(interceptors []Interceptor, info *CallInfo, send RoundTripFunc) RoundTripFunc {
+	next := send
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, n := interceptors[i], next
+		next = func(req *http.Request) (*http.Response, error) {
+			return interceptor(req, info, n)
+		}
+	}
+	return next
+}
+
+// requestCompletedInterceptor returns an interceptor that calls the callback once a response has been received.
+func requestCompletedInterceptor(rc RequestCompletionCallback) Interceptor {
+	return func(req *http.Request, info *CallInfo, next RoundTripFunc) (*http.Response, error) {
+		resp, err := next(req)
+		if err == nil {
+			rc(req, resp)
+		}
+		return resp, err
+	}
+}
+
+var (
+	packagePath = reflect.TypeOf(Client{}).PkgPath()
+	// Matches the functions of exported service methods, e.g. github.com/vartanbeno/go-reddit/reddit.(*PostService).SubmitText
+	serviceMethodRegex = regexp.MustCompile(`^\(\*([A-Z]\w*)Service\)\.([A-Z]\w*)$`)
+)
+
+// callerOperation returns the name of the outermost service method in the call stack, e.g. "Post.SubmitText".
+// It is meant to be called from Client.Do. The methods shared by the post and comment services
+// set their operation themselves, since the stack doesn't tell which service they were called through.
+func callerOperation() string {
+	pcs := make([]uintptr, 32)
+	// skip runtime.Callers, this function, and Client.Do
+	n := runtime.Callers(3, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	var operation string
+	for {
+		frame, more := frames.Next()
+
+		name := strings.TrimPrefix(frame.Function, packagePath+".")
+		if name == frame.Function {
+			// we've left the package
+			break
+		}
+
+		if m := serviceMethodRegex.FindStringSubmatch(name); m != nil {
+			operation = m[1] + "." + m[2]
+		}
+
+		if !more {
+			break
+		}
+	}
+
+	return operation
+}
+
+// callEndpoint returns the path of the request relative to the base URL.
+func (c *Client) callEndpoint(req *http.Request) string {
+	path := strings.TrimPrefix(req.URL.Path, c.BaseURL.Path)
+	return strings.TrimPrefix(path, "/")
+}
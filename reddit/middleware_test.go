@@ -0,0 +1,121 @@
+package reddit
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Interceptors(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/r/golang/top", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "value", r.Header.Get("X-Test"))
+		fmt.Fprint(w, `{"kind": "Listing", "data": {"children": []}}`)
+	})
+
+	var calls []string
+	var infos []CallInfo
+
+	first := func(req *http.Request, info *CallInfo, next RoundTripFunc) (*http.Response, error) {
+		calls = append(calls, "first before")
+		infos = append(infos, *info)
+		req.Header.Set("X-Test", "value")
+		resp, err := next(req)
+		calls = append(calls, "first after")
+		return resp, err
+	}
+
+	second := func(req *http.Request, info *CallInfo, next RoundTripFunc) (*http.Response, error) {
+		calls = append(calls, "second before")
+		resp, err := next(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		calls = append(calls, "second after")
+		return resp, err
+	}
+
+	client.interceptors = []Interceptor{first, second}
+	client.OnRequestCompleted(func(*http.Request, *http.Response) {
+		calls = append(calls, "callback")
+	})
+
+	_, _, err := client.Subreddit.TopPosts(ctx, "golang", nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"first before", "second before", "callback", "second after", "first after"}, calls)
+	require.Equal(t, []CallInfo{{Operation: "Subreddit.TopPosts", Endpoint: "r/golang/top"}}, infos)
+}
+
+func TestClient_Interceptors_ShortCircuit(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var requests int
+	mux.HandleFunc("/api/submit", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	})
+
+	var info *CallInfo
+	client.interceptors = []Interceptor{
+		func(req *http.Request, i *CallInfo, next RoundTripFunc) (*http.Response, error) {
+			info = i
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`{"json": {"data": {"id": "post1", "name": "t3_post1"}}}`)),
+				Request:    req,
+			}, nil
+		},
+	}
+
+	submitted, _, err := client.Post.SubmitText(ctx, SubmitTextOptions{Subreddit: "test", Title: "title"})
+	require.NoError(t, err)
+	require.Equal(t, "t3_post1", submitted.FullID)
+	require.Equal(t, 0, requests)
+	require.Equal(t, &CallInfo{Operation: "Post.SubmitText", Endpoint: "api/submit"}, info)
+
+	client.interceptors = []Interceptor{
+		func(req *http.Request, i *CallInfo, next RoundTripFunc) (*http.Response, error) {
+			return nil, errors.New("blocked")
+		},
+	}
+
+	_, _, err = client.Post.SubmitText(ctx, SubmitTextOptions{Subreddit: "test", Title: "title"})
+	require.EqualError(t, err, "blocked")
+	require.Equal(t, 0, requests)
+}
+
+func TestClient_Interceptors_Operation(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {})
+
+	var operations []string
+	client.interceptors = []Interceptor{
+		func(req *http.Request, info *CallInfo, next RoundTripFunc) (*http.Response, error) {
+			operations = append(operations, info.Operation)
+			return next(req)
+		},
+	}
+
+	_, _ = client.Post.Upvote(ctx, "t3_post1")
+	_, _ = client.Comment.Downvote(ctx, "t1_comment1")
+	_, _ = client.Post.Delete(ctx, "t3_post1")
+	_, _ = client.Comment.Delete(ctx, "t1_comment1")
+	_, _ = client.Moderation.Remove(ctx, "t3_post1")
+	_, _, _ = client.User.Get(ctx, "user1")
+
+	req, err := client.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+	_, _ = client.Do(ctx, req, nil)
+
+	require.Equal(t, []string{"Post.Upvote", "Comment.Downvote", "Post.Delete", "Comment.Delete", "Moderation.Remove", "User.Get", ""}, operations)
+}
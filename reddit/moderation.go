@@ -2,6 +2,7 @@ package reddit
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -42,6 +43,59 @@ type ModAction struct {
 	SubredditID string `json:"sr_id36,omitempty"`
 }
 
+// ModerationItem is a post or a comment from a moderation listing, such as the modqueue.
+// Exactly one of Post and Comment is set.
+type ModerationItem struct {
+	Post    *Post
+	Comment *Comment
+}
+
+// FullID returns the full ID of the post or comment.
+func (i *ModerationItem) FullID() string {
+	if i.Post != nil {
+		return i.Post.FullID
+	}
+	return i.Comment.FullID
+}
+
+type rootModerationListing struct {
+	Kind string `json:"kind"`
+	Data struct {
+		Items  moderationItems `json:"children"`
+		After  string          `json:"after"`
+		Before string          `json:"before"`
+	} `json:"data"`
+}
+
+// moderationItems are the posts and comments of a moderation listing, in the order they were listed.
+type moderationItems []*ModerationItem
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (items *moderationItems) UnmarshalJSON(b []byte) error {
+	var things []thing
+	if err := json.Unmarshal(b, &things); err != nil {
+		return err
+	}
+
+	*items = make(moderationItems, 0, len(things))
+	for _, thing := range things {
+		switch thing.Kind {
+		case kindComment:
+			v := new(Comment)
+			if err := json.Unmarshal(thing.Data, v); err == nil {
+				*items = append(*items, &ModerationItem{Comment: v})
+			}
+		case kindPost:
+			v := new(Post)
+			if err := json.Unmarshal(thing.Data, v); err == nil {
+				*items = append(*items, &ModerationItem{Post: v})
+			}
+		}
+	}
+
+	return nil
+}
+
 // GetActions gets a list of moderator actions on a subreddit.
 func (s *ModerationService) GetActions(ctx context.Context, subreddit string, opts *ListModActionOptions) (*ModActions, *Response, error) {
 	path := fmt.Sprintf("r/%s/about/log", subreddit)
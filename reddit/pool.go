@@ -0,0 +1,114 @@
+package reddit
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+// PoolAccount is an account of a Pool.
+type PoolAccount struct {
+	// Key identifies the account in the pool. It defaults to the account's username.
+	Key         string
+	Credentials Credentials
+	// Options of the account's client, applied after the options shared by all accounts,
+	// e.g. WithUserAgent or WithTokenStore.
+	Opts []Opt
+}
+
+// Pool holds the clients of multiple accounts, and spreads requests across them.
+// All the clients send their requests through the same http.Client transport, so they share
+// its connections, but each one has its own rate limit, token, user agent and Reddit ID.
+// It is safe for concurrent use.
+type Pool struct {
+	keys    []string
+	clients []*Client
+	byKey   map[string]*Client
+
+	// Incremented by every pick, to rotate through accounts with the same budget.
+	picks uint32
+}
+
+// NewPool returns a pool with a client for each account. The opts are applied to every client,
+// before the account's own options. If a nil httpClient is provided, a new http.Client will be used.
+func NewPool(httpClient *http.Client, accounts []PoolAccount, opts ...Opt) (*Pool, error) {
+	if len(accounts) == 0 {
+		return nil, errors.New("accounts: cannot be empty")
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{}
+	}
+
+	pool := &Pool{byKey: make(map[string]*Client, len(accounts))}
+	for _, account := range accounts {
+		key := account.Key
+		if key == "" {
+			key = account.Credentials.Username
+		}
+		if key == "" {
+			return nil, errors.New("account key: cannot be empty")
+		}
+		if _, ok := pool.byKey[key]; ok {
+			return nil, fmt.Errorf("account %q: duplicate key", key)
+		}
+
+		// NewClient modifies the http.Client it's given, so each account gets a copy that shares its transport
+		accountHTTPClient := &http.Client{
+			Transport: httpClient.Transport,
+			Jar:       httpClient.Jar,
+			Timeout:   httpClient.Timeout,
+		}
+
+		creds := account.Credentials
+		client, err := NewClient(accountHTTPClient, &creds, append(opts[:len(opts):len(opts)], account.Opts...)...)
+		if err != nil {
+			return nil, fmt.Errorf("account %q: %w", key, err)
+		}
+
+		pool.keys = append(pool.keys, key)
+		pool.clients = append(pool.clients, client)
+		pool.byKey[key] = client
+	}
+
+	return pool, nil
+}
+
+// Keys returns the keys of the pool's accounts, in the order they were provided.
+func (p *Pool) Keys() []string {
+	return append([]string(nil), p.keys...)
+}
+
+// Account returns the client of the account with the key.
+func (p *Pool) Account(key string) (*Client, bool) {
+	client, ok := p.byKey[key]
+	return client, ok
+}
+
+// Pick returns the client of the account with the most requests remaining in its rate limit window,
+// as of the latest response it received. Accounts that haven't made requests yet, or whose window
+// has reset, have their full budget. Accounts with the same budget are picked in turn.
+func (p *Pool) Pick() *Client {
+	start := int(atomic.AddUint32(&p.picks, 1)-1) % len(p.clients)
+
+	var picked *Client
+	best := -1
+	for i := range p.clients {
+		client := p.clients[(start+i)%len(p.clients)]
+		if budget := rateBudget(client.Rate()); budget > best {
+			picked, best = client, budget
+		}
+	}
+
+	return picked
+}
+
+// rateBudget returns the number of requests that can still be made in the rate limit window.
+func rateBudget(rate Rate) int {
+	if rate.Reset.IsZero() || !rate.Reset.After(time.Now()) {
+		return math.MaxInt32
+	}
+	return rate.Remaining
+}
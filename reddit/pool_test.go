@@ -0,0 +1,113 @@
+package reddit
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type countingTransport struct {
+	mu       sync.Mutex
+	requests int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	t.requests++
+	t.mu.Unlock()
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func setupPool(t *testing.T, remaining map[string]int, accounts ...PoolAccount) (*Pool, *countingTransport, *http.ServeMux, func()) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+
+	mux.HandleFunc("/api/v1/access_token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		w.Header().Add(headerContentType, mediaTypeJSON)
+		fmt.Fprintf(w, `{"access_token":"token-%s","token_type":"bearer","expires_in":3600}`, r.Form.Get("username"))
+	})
+	mux.HandleFunc("/api/v1/me", func(w http.ResponseWriter, r *http.Request) {
+		username := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer token-")
+		w.Header().Set(headerRateLimitRemaining, fmt.Sprint(remaining[username]))
+		w.Header().Set(headerRateLimitUsed, "0")
+		w.Header().Set(headerRateLimitReset, "600")
+		fmt.Fprintf(w, `{"id":"id-%s","name":%q}`, username, username)
+	})
+
+	transport := new(countingTransport)
+	pool, err := NewPool(&http.Client{Transport: transport}, accounts, WithBaseURL(server.URL), WithTokenURL(server.URL+"/api/v1/access_token"))
+	require.NoError(t, err)
+
+	return pool, transport, mux, server.Close
+}
+
+func TestPool(t *testing.T) {
+	pool, transport, _, teardown := setupPool(t, map[string]int{"user1": 100, "user2": 500, "user3": 300},
+		PoolAccount{Credentials: Credentials{"id", "secret", "user1", "password"}},
+		PoolAccount{Key: "second", Credentials: Credentials{"id", "secret", "user2", "password"}},
+		PoolAccount{Credentials: Credentials{"id", "secret", "user3", "password"}, Opts: []Opt{WithUserAgent("bot3")}},
+	)
+	defer teardown()
+
+	require.Equal(t, []string{"user1", "second", "user3"}, pool.Keys())
+
+	client, ok := pool.Account("second")
+	require.True(t, ok)
+	require.Equal(t, "user2", client.Username)
+
+	_, ok = pool.Account("user2")
+	require.False(t, ok)
+
+	client, _ = pool.Account("user3")
+	require.Equal(t, "bot3", client.UserAgent())
+
+	for _, key := range pool.Keys() {
+		client, _ := pool.Account(key)
+		id, err := client.RedditID(ctx)
+		require.NoError(t, err)
+		require.Equal(t, "id-"+client.Username, id)
+	}
+	// each account requested its token and its info through the shared transport
+	require.Equal(t, 6, transport.requests)
+
+	require.Equal(t, "user2", pool.Pick().Username)
+	require.Equal(t, "user2", pool.Pick().Username)
+}
+
+func TestPool_Pick(t *testing.T) {
+	pool, _, _, teardown := setupPool(t, nil,
+		PoolAccount{Credentials: Credentials{"id", "secret", "user1", "password"}},
+		PoolAccount{Credentials: Credentials{"id", "secret", "user2", "password"}},
+	)
+	defer teardown()
+
+	// accounts without a known rate limit are picked in turn
+	require.Equal(t, "user1", pool.Pick().Username)
+	require.Equal(t, "user2", pool.Pick().Username)
+	require.Equal(t, "user1", pool.Pick().Username)
+}
+
+func TestNewPool_Errors(t *testing.T) {
+	_, err := NewPool(nil, nil)
+	require.EqualError(t, err, "accounts: cannot be empty")
+
+	_, err = NewPool(nil, []PoolAccount{{}})
+	require.EqualError(t, err, "account key: cannot be empty")
+
+	_, err = NewPool(nil, []PoolAccount{
+		{Credentials: Credentials{Username: "user1"}},
+		{Key: "user1", Credentials: Credentials{Username: "user2"}},
+	})
+	require.EqualError(t, err, `account "user1": duplicate key`)
+
+	_, err = NewPool(nil, []PoolAccount{
+		{Credentials: Credentials{Username: "user1"}, Opts: []Opt{WithUserAgent("")}},
+	})
+	require.EqualError(t, err, `account "user1": user agent: cannot be empty`)
+}
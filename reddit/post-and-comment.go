@@ -14,6 +14,8 @@ import (
 // Reddit API docs: https://www.reddit.com/dev/api/#section_links_and_comments
 type postAndCommentService struct {
 	client *Client
+	// Name of the service the methods are called through, i.e. "Post" or "Comment".
+	service string
 }
 
 type vote int
@@ -25,6 +27,12 @@ const (
 	upvote
 )
 
+// do sends the request as the operation of the service the method was called through, e.g. "Comment.Delete".
+// The call stack only shows postAndCommentService, so the operation can't be taken from it.
+func (s *postAndCommentService) do(ctx context.Context, req *http.Request, method string) (*Response, error) {
+	return s.client.doOperation(ctx, req, nil, s.service+"."+method)
+}
+
 // Delete deletes a post or comment via its full ID.
 func (s *postAndCommentService) Delete(ctx context.Context, id string) (*Response, error) {
 	path := "api/del"
@@ -37,7 +45,7 @@ func (s *postAndCommentService) Delete(ctx context.Context, id string) (*Respons
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.do(ctx, req, "Delete")
 }
 
 // Save saves a post or comment.
@@ -52,7 +60,7 @@ func (s *postAndCommentService) Save(ctx context.Context, id string) (*Response,
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.do(ctx, req, "Save")
 }
 
 // Unsave unsaves a post or comment.
@@ -67,7 +75,7 @@ func (s *postAndCommentService) Unsave(ctx context.Context, id string) (*Respons
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.do(ctx, req, "Unsave")
 }
 
 // EnableReplies enables inbox replies for one of your posts or comments.
@@ -83,7 +91,7 @@ func (s *postAndCommentService) EnableReplies(ctx context.Context, id string) (*
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.do(ctx, req, "EnableReplies")
 }
 
 // DisableReplies dsables inbox replies for one of your posts or comments.
@@ -99,7 +107,7 @@ func (s *postAndCommentService) DisableReplies(ctx context.Context, id string) (
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.do(ctx, req, "DisableReplies")
 }
 
 // Lock locks a post or comment, preventing it from receiving new comments.
@@ -114,7 +122,7 @@ func (s *postAndCommentService) Lock(ctx context.Context, id string) (*Response,
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.do(ctx, req, "Lock")
 }
 
 // Unlock unlocks a post or comment, allowing it to receive new comments.
@@ -129,10 +137,10 @@ func (s *postAndCommentService) Unlock(ctx context.Context, id string) (*Respons
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.do(ctx, req, "Unlock")
 }
 
-func (s *postAndCommentService) vote(ctx context.Context, id string, vote vote) (*Response, error) {
+func (s *postAndCommentService) vote(ctx context.Context, operation string, id string, vote vote) (*Response, error) {
 	path := "api/vote"
 
 	form := url.Values{}
@@ -145,22 +153,22 @@ func (s *postAndCommentService) vote(ctx context.Context, id string, vote vote)
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.do(ctx, req, operation)
 }
 
 // Upvote upvotes a post or a comment.
 func (s *postAndCommentService) Upvote(ctx context.Context, id string) (*Response, error) {
-	return s.vote(ctx, id, upvote)
+	return s.vote(ctx, "Upvote", id, upvote)
 }
 
 // Downvote downvotes a post or a comment.
 func (s *postAndCommentService) Downvote(ctx context.Context, id string) (*Response, error) {
-	return s.vote(ctx, id, downvote)
+	return s.vote(ctx, "Downvote", id, downvote)
 }
 
 // RemoveVote removes your vote on a post or a comment.
 func (s *postAndCommentService) RemoveVote(ctx context.Context, id string) (*Response, error) {
-	return s.vote(ctx, id, novote)
+	return s.vote(ctx, "RemoveVote", id, novote)
 }
 
 // Report reports a post or comment.
@@ -178,5 +186,5 @@ func (s *postAndCommentService) Report(ctx context.Context, id string, reason st
 		return nil, err
 	}
 
-	return s.client.Do(ctx, req, nil)
+	return s.do(ctx, req, "Report")
 }
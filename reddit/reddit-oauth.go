@@ -13,6 +13,8 @@ Docs:
 	  use the app. Only has access to your account.
 
 Best option for a client like this is to use the script option.
+If you are building a web app where other users log in with their own Reddit accounts,
+use the web app option along with the authorization code flow (see AuthCodeConfig).
 
 2. After creating the app, you will get a client id and client secret.
 
@@ -35,11 +37,17 @@ package reddit
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
 
 	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
 )
 
+const grantTypeInstalledClient = "https://oauth.reddit.com/grants/installed_client"
+
 type oauthTokenSource struct {
 	ctx                context.Context
 	config             *oauth2.Config
@@ -50,7 +58,7 @@ func (s *oauthTokenSource) Token() (*oauth2.Token, error) {
 	return s.config.PasswordCredentialsToken(s.ctx, s.username, s.password)
 }
 
-func oauthTransport(client *Client) http.RoundTripper {
+func oauthTransport(client *Client) *oauth2.Transport {
 	// We need to set a custom user agent, because using the one set by default by the
 	// stdlib gives us 429 Too Many Request responses from the Reddit API.
 	userAgentTransport := &userAgentTransport{
@@ -71,15 +79,162 @@ func oauthTransport(client *Client) http.RoundTripper {
 		},
 	}
 
-	tokenSource := oauth2.ReuseTokenSource(nil, &oauthTokenSource{
-		ctx:      ctx,
-		config:   config,
-		username: client.Username,
-		password: client.Password,
-	})
+	var tokenSource oauth2.TokenSource
+	if client.appOnly {
+		tokenSource = appOnlyTokenSource(ctx, client)
+	} else if client.token != nil {
+		// the token came from the authorization code flow, so
+		// we use its refresh token to get new ones once it expires
+		token := client.token
+		if client.tokenStore != nil {
+			// the stored token might be more recent than the one we were given
+			if stored, err := client.tokenStore.LoadToken(); err == nil && stored != nil && stored.RefreshToken != "" {
+				token = stored
+			}
+		}
+		tokenSource = config.TokenSource(ctx, token)
+	} else {
+		tokenSource = oauth2.ReuseTokenSource(nil, &oauthTokenSource{
+			ctx:      ctx,
+			config:   config,
+			username: client.Username,
+			password: client.Password,
+		})
+	}
+
+	if client.tokenStore != nil {
+		tokenSource = oauth2.ReuseTokenSource(nil, &storeTokenSource{
+			store: client.tokenStore,
+			base:  tokenSource,
+		})
+	}
 
 	return &oauth2.Transport{
 		Source: tokenSource,
 		Base:   userAgentTransport,
 	}
 }
+
+// tokenHTTPClient returns an HTTP client for requests made to the token endpoints,
+// which are authenticated with the app's credentials instead of an access token.
+func (c *Client) tokenHTTPClient() *http.Client {
+	if c.oauth2Transport != nil {
+		return &http.Client{Transport: c.oauth2Transport.Base}
+	}
+	return &http.Client{Transport: &userAgentTransport{userAgent: c.UserAgent(), Base: c.client.Transport}}
+}
+
+// appOnlyTokenSource returns a token source for application-only authentication.
+// Apps that have a secret use the client_credentials grant, while installed apps
+// identify themselves with a device ID instead.
+func appOnlyTokenSource(ctx context.Context, client *Client) oauth2.TokenSource {
+	config := &clientcredentials.Config{
+		ClientID:     client.ID,
+		ClientSecret: client.Secret,
+		TokenURL:     client.TokenURL.String(),
+		AuthStyle:    oauth2.AuthStyleInHeader,
+	}
+
+	if client.deviceID != "" {
+		config.EndpointParams = url.Values{
+			"grant_type": {grantTypeInstalledClient},
+			"device_id":  {client.deviceID},
+		}
+	}
+
+	return config.TokenSource(ctx)
+}
+
+// AuthCodeConfig is used to authenticate Reddit users through the authorization code flow.
+// This is the flow used by web apps, where users log in with their own Reddit account
+// and grant your app permissions to act on their behalf.
+//
+// First, redirect the user to the URL returned by AuthCodeURL. Once they accept, Reddit
+// redirects them to your RedirectURL with the "state" and "code" query parameters. Verify
+// the state, then call Exchange with the code to get a token. The token can be used to
+// create a client with the WithToken option.
+//
+// Reddit API docs: https://github.com/reddit-archive/reddit/wiki/OAuth2#authorization
+type AuthCodeConfig struct {
+	// The client ID and secret of your app.
+	ID     string
+	Secret string
+
+	// Must match the redirect URI registered for your app exactly.
+	RedirectURL string
+
+	// The permissions your app requests, e.g. "identity", "read", "modposts".
+	Scopes []string
+
+	// If true, the user grants access indefinitely, and the token comes with a refresh token
+	// that can be used to get new access tokens once the current one expires.
+	// Otherwise, access expires after an hour.
+	Permanent bool
+
+	// The user agent sent when exchanging the code for a token.
+	// If empty, a default one is used.
+	UserAgent string
+
+	// If empty, these default to Reddit's authorization and access token URLs.
+	AuthURL  string
+	TokenURL string
+}
+
+func (c *AuthCodeConfig) oauth2Config() *oauth2.Config {
+	authURL := c.AuthURL
+	if authURL == "" {
+		authURL = defaultAuthURL
+	}
+
+	tokenURL := c.TokenURL
+	if tokenURL == "" {
+		tokenURL = defaultTokenURL
+	}
+
+	return &oauth2.Config{
+		ClientID:     c.ID,
+		ClientSecret: c.Secret,
+		RedirectURL:  c.RedirectURL,
+		Scopes:       c.Scopes,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:   authURL,
+			TokenURL:  tokenURL,
+			AuthStyle: oauth2.AuthStyleInHeader,
+		},
+	}
+}
+
+// AuthCodeURL returns the URL to which you redirect users so they can grant your app permissions.
+// state should be a unique, unguessable string that you verify once Reddit redirects the user back.
+func (c *AuthCodeConfig) AuthCodeURL(state string) string {
+	duration := "temporary"
+	if c.Permanent {
+		duration = "permanent"
+	}
+
+	return c.oauth2Config().AuthCodeURL(state, oauth2.SetAuthURLParam("duration", duration))
+}
+
+// Exchange exchanges the code Reddit sent to your redirect URL for a token.
+// If httpClient is nil, a new http.Client is used.
+func (c *AuthCodeConfig) Exchange(ctx context.Context, httpClient *http.Client, code string) (*oauth2.Token, error) {
+	if code == "" {
+		return nil, errors.New("code: cannot be empty")
+	}
+
+	var base http.RoundTripper
+	if httpClient != nil {
+		base = httpClient.Transport
+	}
+
+	userAgent := c.UserAgent
+	if userAgent == "" {
+		userAgent = fmt.Sprintf("golang:%s:v%s", libraryName, libraryVersion)
+	}
+
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, &http.Client{
+		Transport: &userAgentTransport{userAgent: userAgent, Base: base},
+	})
+
+	return c.oauth2Config().Exchange(ctx, code)
+}
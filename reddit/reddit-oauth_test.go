@@ -0,0 +1,245 @@
+package reddit
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+func TestAuthCodeConfig_AuthCodeURL(t *testing.T) {
+	config := &AuthCodeConfig{
+		ID:          "id1",
+		Secret:      "secret1",
+		RedirectURL: "https://example.com/callback",
+		Scopes:      []string{"identity", "modposts"},
+		Permanent:   true,
+	}
+
+	u, err := url.Parse(config.AuthCodeURL("state1"))
+	require.NoError(t, err)
+	require.Equal(t, "www.reddit.com", u.Host)
+	require.Equal(t, "/api/v1/authorize", u.Path)
+
+	query := u.Query()
+	require.Equal(t, "id1", query.Get("client_id"))
+	require.Equal(t, "code", query.Get("response_type"))
+	require.Equal(t, "state1", query.Get("state"))
+	require.Equal(t, "https://example.com/callback", query.Get("redirect_uri"))
+	require.Equal(t, "permanent", query.Get("duration"))
+	require.Equal(t, "identity modposts", query.Get("scope"))
+
+	config.Permanent = false
+	config.AuthURL = "http://localhost:8080/authorize"
+
+	u, err = url.Parse(config.AuthCodeURL("state2"))
+	require.NoError(t, err)
+	require.Equal(t, "localhost:8080", u.Host)
+	require.Equal(t, "temporary", u.Query().Get("duration"))
+}
+
+func TestAuthCodeConfig_Exchange(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v1/access_token", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Contains(t, r.Header.Get(headerUserAgent), libraryName)
+
+		id, secret, ok := r.BasicAuth()
+		require.True(t, ok)
+		require.Equal(t, "id1", id)
+		require.Equal(t, "secret1", secret)
+
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "authorization_code", r.Form.Get("grant_type"))
+		require.Equal(t, "code1", r.Form.Get("code"))
+		require.Equal(t, "https://example.com/callback", r.Form.Get("redirect_uri"))
+
+		w.Header().Add(headerContentType, mediaTypeJSON)
+		fmt.Fprint(w, `{
+			"access_token": "token1",
+			"token_type": "bearer",
+			"expires_in": 3600,
+			"refresh_token": "refresh1",
+			"scope": "identity modposts"
+		}`)
+	})
+
+	config := &AuthCodeConfig{
+		ID:          "id1",
+		Secret:      "secret1",
+		RedirectURL: "https://example.com/callback",
+		TokenURL:    server.URL + "/api/v1/access_token",
+	}
+
+	token, err := config.Exchange(ctx, nil, "code1")
+	require.NoError(t, err)
+	require.Equal(t, "token1", token.AccessToken)
+	require.Equal(t, "refresh1", token.RefreshToken)
+	require.True(t, token.Valid())
+
+	_, err = config.Exchange(ctx, nil, "")
+	require.EqualError(t, err, "code: cannot be empty")
+}
+
+func TestClient_WithToken(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var refreshed int
+	mux.HandleFunc("/api/v1/access_token", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "refresh_token", r.Form.Get("grant_type"))
+		require.Equal(t, "refresh1", r.Form.Get("refresh_token"))
+		refreshed++
+
+		w.Header().Add(headerContentType, mediaTypeJSON)
+		fmt.Fprint(w, `{
+			"access_token": "token2",
+			"token_type": "bearer",
+			"expires_in": 3600,
+			"refresh_token": "refresh1",
+			"scope": "identity"
+		}`)
+	})
+
+	mux.HandleFunc("/api/v1/me", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "Bearer token2", r.Header.Get("Authorization"))
+		fmt.Fprint(w, `{"id": "user1id", "name": "user1"}`)
+	})
+
+	// the token is expired, so it should get refreshed before the first request
+	token := &oauth2.Token{
+		AccessToken:  "token1",
+		TokenType:    "bearer",
+		RefreshToken: "refresh1",
+		Expiry:       time.Now().Add(-time.Minute),
+	}
+
+	client, err := NewClient(nil,
+		&Credentials{ID: "id1", Secret: "secret1"},
+		WithBaseURL(server.URL),
+		WithTokenURL(server.URL+"/api/v1/access_token"),
+		WithToken(token),
+	)
+	require.NoError(t, err)
+
+	id, _, err := client.id(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "t2_user1id", id)
+	require.Equal(t, "user1", client.Username)
+	require.Equal(t, 1, refreshed)
+
+	_, _, err = client.Account.Info(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, refreshed)
+}
+
+func TestClient_ApplicationOnlyOAuth(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v1/access_token", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Contains(t, r.Header.Get(headerUserAgent), libraryName)
+
+		id, secret, ok := r.BasicAuth()
+		require.True(t, ok)
+		require.Equal(t, "id1", id)
+		require.Equal(t, "secret1", secret)
+
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "client_credentials", r.Form.Get("grant_type"))
+
+		w.Header().Add(headerContentType, mediaTypeJSON)
+		fmt.Fprint(w, `{
+			"access_token": "token1",
+			"token_type": "bearer",
+			"expires_in": 3600,
+			"scope": "*"
+		}`)
+	})
+
+	mux.HandleFunc("/r/golang/about", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "Bearer token1", r.Header.Get("Authorization"))
+		fmt.Fprint(w, `{"kind": "t5", "data": {"display_name": "golang"}}`)
+	})
+
+	client, err := NewClient(nil,
+		&Credentials{ID: "id1", Secret: "secret1"},
+		WithBaseURL(server.URL),
+		WithTokenURL(server.URL+"/api/v1/access_token"),
+		WithApplicationOnlyOAuth,
+	)
+	require.NoError(t, err)
+
+	subreddit, _, err := client.Subreddit.Get(ctx, "golang")
+	require.NoError(t, err)
+	require.Equal(t, "golang", subreddit.Name)
+
+	_, _, err = client.Account.Info(ctx)
+	require.Equal(t, ErrNoUserContext, err)
+
+	_, _, err = client.id(ctx)
+	require.Equal(t, ErrNoUserContext, err)
+}
+
+func TestClient_InstalledClientOAuth(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v1/access_token", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+
+		id, secret, ok := r.BasicAuth()
+		require.True(t, ok)
+		require.Equal(t, "id1", id)
+		require.Equal(t, "", secret)
+
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "https://oauth.reddit.com/grants/installed_client", r.Form.Get("grant_type"))
+		require.Equal(t, "device1device1device1", r.Form.Get("device_id"))
+
+		w.Header().Add(headerContentType, mediaTypeJSON)
+		fmt.Fprint(w, `{
+			"access_token": "token1",
+			"token_type": "bearer",
+			"expires_in": 3600,
+			"scope": "*"
+		}`)
+	})
+
+	mux.HandleFunc("/r/golang/about", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "Bearer token1", r.Header.Get("Authorization"))
+		fmt.Fprint(w, `{"kind": "t5", "data": {"display_name": "golang"}}`)
+	})
+
+	client, err := NewClient(nil,
+		&Credentials{ID: "id1"},
+		WithBaseURL(server.URL),
+		WithTokenURL(server.URL+"/api/v1/access_token"),
+		WithInstalledClientOAuth("device1device1device1"),
+	)
+	require.NoError(t, err)
+
+	subreddit, _, err := client.Subreddit.Get(ctx, "golang")
+	require.NoError(t, err)
+	require.Equal(t, "golang", subreddit.Name)
+
+	_, err = client.Account.AddTrusted(ctx, "user1")
+	require.Equal(t, ErrNoUserContext, err)
+}
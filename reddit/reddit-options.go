@@ -1,8 +1,11 @@
 package reddit
 
 import (
+	"errors"
 	"net/url"
 	"os"
+
+	"golang.org/x/oauth2"
 )
 
 // Opt is a configuration option to initialize a client.
@@ -60,3 +63,162 @@ func WithTokenURL(u string) Opt {
 		return nil
 	}
 }
+
+// WithUserAgent sets the user agent of the client's requests.
+// By default, it's "golang:github.com/vartanbeno/go-reddit:v{version} (by /u/{username})".
+func WithUserAgent(ua string) Opt {
+	return func(c *Client) error {
+		if ua == "" {
+			return errors.New("user agent: cannot be empty")
+		}
+		c.userAgent = ua
+		return nil
+	}
+}
+
+// WithRateLimitWait makes the client block until the rate limit window resets
+// whenever the most recent response indicated that no requests remain in it,
+// instead of sending requests that would get a 429 Too Many Requests response.
+// The wait is cancelled if the request's context is done.
+func WithRateLimitWait(c *Client) error {
+	c.waitForRateLimit = true
+	return nil
+}
+
+// WithRetryPolicy sets the policy used to retry requests that failed
+// because of rate limiting or a temporary server error.
+func WithRetryPolicy(policy RetryPolicy) Opt {
+	return func(c *Client) error {
+		c.retryPolicy = &policy
+		return nil
+	}
+}
+
+// WithToken makes the client authenticate with a token obtained through the authorization
+// code flow (see AuthCodeConfig), instead of the username and password of the credentials.
+// Only the ID and Secret of the credentials are used, to refresh the token against the
+// client's token URL once it expires. This requires the token to have a refresh token,
+// i.e. access must have been granted permanently. NewClient returns an error if the token
+// has a refresh token but the client has no credentials.
+func WithToken(token *oauth2.Token) Opt {
+	return func(c *Client) error {
+		if token == nil {
+			return errors.New("token: cannot be nil")
+		}
+		c.token = token
+		return nil
+	}
+}
+
+// WithApplicationOnlyOAuth makes the client authenticate as your app rather than as a user,
+// using the client_credentials grant. Only the ID and Secret of the credentials are used.
+// This is useful for read-only access to Reddit, since the client has no user context.
+// Methods that act on behalf of a user (e.g. the ones in the AccountService) return ErrNoUserContext.
+func WithApplicationOnlyOAuth(c *Client) error {
+	c.appOnly = true
+	return nil
+}
+
+// WithInstalledClientOAuth makes the client authenticate as your installed app rather than as a user,
+// using the installed_client grant. Installed apps don't have a secret, so only the ID of the credentials is used.
+// deviceID should be a unique 20-30 character identifier for the device, which you persist across runs.
+// If you don't want to track the device, use "DO_NOT_TRACK_THIS_DEVICE".
+// Methods that act on behalf of a user (e.g. the ones in the AccountService) return ErrNoUserContext.
+func WithInstalledClientOAuth(deviceID string) Opt {
+	return func(c *Client) error {
+		if deviceID == "" {
+			return errors.New("deviceID: cannot be empty")
+		}
+		c.appOnly = true
+		c.deviceID = deviceID
+		return nil
+	}
+}
+
+// WithTokenStore sets the store used to persist access tokens.
+// Before requesting a new token from Reddit, the client checks the store for a valid one,
+// and it saves every new token it gets into it. This lets short-lived processes reuse a
+// token instead of authenticating again every time they start.
+func WithTokenStore(store TokenStore) Opt {
+	return func(c *Client) error {
+		if store == nil {
+			return errors.New("store: cannot be nil")
+		}
+		c.tokenStore = store
+		return nil
+	}
+}
+
+// WithInterceptors adds interceptors to the client, which see every request it sends,
+// along with the service call it is made for. They can be used for logging, metrics,
+// modifying requests, or short-circuiting them, e.g. to serve them from a cache.
+// Interceptors are called in the order they are provided, the first one being the outermost.
+func WithInterceptors(interceptors ...Interceptor) Opt {
+	return func(c *Client) error {
+		for _, interceptor := range interceptors {
+			if interceptor == nil {
+				return errors.New("interceptor: cannot be nil")
+			}
+		}
+		c.interceptors = append(c.interceptors, interceptors...)
+		return nil
+	}
+}
+
+// WithInstrumentation sets the instrumentation that gets notified of every API call made by the client,
+// e.g. to trace them or record metrics about them. By default, the client uses NopInstrumentation.
+func WithInstrumentation(instrumentation Instrumentation) Opt {
+	return func(c *Client) error {
+		if instrumentation == nil {
+			return errors.New("instrumentation: cannot be nil")
+		}
+		c.instrumentation = instrumentation
+		return nil
+	}
+}
+
+// WithCache makes the client serve the responses of GET requests from a cache, for as long as
+// the TTL of their operation. Successful writes invalidate the cached responses of the resource
+// they modify, e.g. updating the post flairs of a subreddit invalidates its cached responses.
+// Writes that only name a subreddit by its full ID, e.g. Moderation.Leave, invalidate it once
+// the client has fetched the subreddit with Subreddit.Get, which is how it learns the ID.
+// Use BypassCache to skip the cache for specific calls. Caches shouldn't be shared between
+// clients of different users, since some responses depend on the user making the request.
+func WithCache(config CacheConfig) Opt {
+	return func(c *Client) error {
+		if config.Cache == nil {
+			return errors.New("cache: cannot be nil")
+		}
+		c.cache = &config
+		return nil
+	}
+}
+
+// WithDryRun makes the client observe-only: mutating requests (POST, PUT, PATCH, and DELETE) aren't
+// sent, but passed to record, and they get a synthetic successful response. The values returned by
+// mutating calls are left empty, e.g. the *Submitted returned by Post.SubmitText is nil.
+// GET requests are still sent, and so are POST requests that only read data, such as the ones
+// of Comment.LoadMoreReplies. Use DryRunLog.Record to keep the requests in memory.
+func WithDryRun(record func(*DryRunRequest)) Opt {
+	return func(c *Client) error {
+		if record == nil {
+			return errors.New("record: cannot be nil")
+		}
+		c.dryRun = record
+		return nil
+	}
+}
+
+// WithAuditSink sets a sink that records the outcome of every mutating request made by the client
+// (i.e. every non-GET request, except the POST requests that only read data, such as the ones of
+// Comment.LoadMoreReplies), once its retries are done. If the sink returns an error for a request
+// that otherwise succeeded, the call returns an *AuditError.
+func WithAuditSink(sink AuditSink) Opt {
+	return func(c *Client) error {
+		if sink == nil {
+			return errors.New("sink: cannot be nil")
+		}
+		c.auditSink = sink
+		return nil
+	}
+}
@@ -1,10 +1,13 @@
 package reddit
 
 import (
+	"net/http"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
 )
 
 func TestFromEnv(t *testing.T) {
@@ -45,3 +48,127 @@ func TestWithTokenURL(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, tokenURL, c.TokenURL.String())
 }
+
+func TestWithRateLimitWait(t *testing.T) {
+	c, err := NewClient(nil, nil, WithRateLimitWait)
+	require.NoError(t, err)
+	require.True(t, c.waitForRateLimit)
+}
+
+func TestWithRetryPolicy(t *testing.T) {
+	policy := RetryPolicy{MaxRetries: 3}
+	c, err := NewClient(nil, nil, WithRetryPolicy(policy))
+	require.NoError(t, err)
+	require.Equal(t, &policy, c.retryPolicy)
+}
+
+func TestWithToken(t *testing.T) {
+	token := &oauth2.Token{AccessToken: "token1", RefreshToken: "refresh1"}
+	c, err := NewClient(nil, &Credentials{ID: "id1", Secret: "secret1"}, WithToken(token))
+	require.NoError(t, err)
+	require.Equal(t, token, c.token)
+
+	_, err = NewClient(nil, nil, WithToken(token))
+	require.EqualError(t, err, "creds: cannot be nil when the token has a refresh token")
+
+	// a token without a refresh token is used until it expires, so it doesn't need credentials
+	c, err = NewClient(nil, nil, WithToken(&oauth2.Token{AccessToken: "token1"}))
+	require.NoError(t, err)
+	require.NotNil(t, c.oauth2Transport)
+
+	_, err = NewClient(nil, nil, WithToken(nil))
+	require.EqualError(t, err, "token: cannot be nil")
+}
+
+func TestWithApplicationOnlyOAuth(t *testing.T) {
+	c, err := NewClient(nil, &Credentials{ID: "id1", Secret: "secret1"}, WithApplicationOnlyOAuth)
+	require.NoError(t, err)
+	require.True(t, c.appOnly)
+	require.Empty(t, c.deviceID)
+}
+
+func TestWithInstalledClientOAuth(t *testing.T) {
+	c, err := NewClient(nil, &Credentials{ID: "id1"}, WithInstalledClientOAuth("DO_NOT_TRACK_THIS_DEVICE"))
+	require.NoError(t, err)
+	require.True(t, c.appOnly)
+	require.Equal(t, "DO_NOT_TRACK_THIS_DEVICE", c.deviceID)
+
+	_, err = NewClient(nil, nil, WithInstalledClientOAuth(""))
+	require.EqualError(t, err, "deviceID: cannot be empty")
+}
+
+func TestWithTokenStore(t *testing.T) {
+	store := new(MemoryTokenStore)
+	c, err := NewClient(nil, nil, WithTokenStore(store))
+	require.NoError(t, err)
+	require.Equal(t, store, c.tokenStore)
+
+	_, err = NewClient(nil, nil, WithTokenStore(nil))
+	require.EqualError(t, err, "store: cannot be nil")
+}
+
+func TestWithInterceptors(t *testing.T) {
+	interceptor := func(req *http.Request, info *CallInfo, next RoundTripFunc) (*http.Response, error) {
+		return next(req)
+	}
+
+	c, err := NewClient(nil, nil, WithInterceptors(interceptor, interceptor))
+	require.NoError(t, err)
+	require.Len(t, c.interceptors, 2)
+
+	_, err = NewClient(nil, nil, WithInterceptors(nil))
+	require.EqualError(t, err, "interceptor: cannot be nil")
+}
+
+func TestWithInstrumentation(t *testing.T) {
+	c, err := NewClient(nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, NopInstrumentation{}, c.instrumentation)
+
+	instrumentation := new(testInstrumentation)
+	c, err = NewClient(nil, nil, WithInstrumentation(instrumentation))
+	require.NoError(t, err)
+	require.Equal(t, instrumentation, c.instrumentation)
+
+	_, err = NewClient(nil, nil, WithInstrumentation(nil))
+	require.EqualError(t, err, "instrumentation: cannot be nil")
+}
+
+func TestWithCache(t *testing.T) {
+	cache := NewLRUCache(10)
+	c, err := NewClient(nil, nil, WithCache(CacheConfig{Cache: cache, DefaultTTL: time.Minute}))
+	require.NoError(t, err)
+	require.Equal(t, &CacheConfig{Cache: cache, DefaultTTL: time.Minute}, c.cache)
+
+	_, err = NewClient(nil, nil, WithCache(CacheConfig{}))
+	require.EqualError(t, err, "cache: cannot be nil")
+}
+
+func TestWithDryRun(t *testing.T) {
+	log := new(DryRunLog)
+	c, err := NewClient(nil, nil, WithDryRun(log.Record))
+	require.NoError(t, err)
+	require.NotNil(t, c.dryRun)
+
+	_, err = NewClient(nil, nil, WithDryRun(nil))
+	require.EqualError(t, err, "record: cannot be nil")
+}
+
+func TestWithAuditSink(t *testing.T) {
+	sink := new(auditLog)
+	c, err := NewClient(nil, nil, WithAuditSink(sink))
+	require.NoError(t, err)
+	require.Equal(t, sink, c.auditSink)
+
+	_, err = NewClient(nil, nil, WithAuditSink(nil))
+	require.EqualError(t, err, "sink: cannot be nil")
+}
+
+func TestWithUserAgent(t *testing.T) {
+	c, err := NewClient(nil, nil, WithUserAgent("test user agent"))
+	require.NoError(t, err)
+	require.Equal(t, "test user agent", c.UserAgent())
+
+	_, err = NewClient(nil, nil, WithUserAgent(""))
+	require.EqualError(t, err, "user agent: cannot be empty")
+}
@@ -1,16 +1,22 @@
 package reddit
 
+//go:generate go run ../internal/genapi
+
 import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"reflect"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/google/go-querystring/query"
 	"golang.org/x/oauth2"
@@ -20,8 +26,10 @@ const (
 	libraryName    = "github.com/vartanbeno/go-reddit"
 	libraryVersion = "1.0.0"
 
-	defaultBaseURL  = "https://oauth.reddit.com"
-	defaultTokenURL = "https://www.reddit.com/api/v1/access_token"
+	defaultBaseURL         = "https://oauth.reddit.com"
+	defaultBaseURLReadonly = "https://www.reddit.com"
+	defaultAuthURL         = "https://www.reddit.com/api/v1/authorize"
+	defaultTokenURL        = "https://www.reddit.com/api/v1/access_token"
 
 	mediaTypeJSON = "application/json"
 	mediaTypeForm = "application/x-www-form-urlencoded"
@@ -29,6 +37,11 @@ const (
 	headerContentType = "Content-Type"
 	headerAccept      = "Accept"
 	headerUserAgent   = "User-Agent"
+
+	headerRateLimitUsed      = "X-Ratelimit-Used"
+	headerRateLimitRemaining = "X-Ratelimit-Remaining"
+	headerRateLimitReset     = "X-Ratelimit-Reset"
+	headerRetryAfter         = "Retry-After"
 )
 
 // cloneRequest returns a clone of the provided *http.Request.
@@ -96,30 +109,72 @@ type Client struct {
 	Username string
 	Password string
 
-	// This is the client's user ID in Reddit's database.
-	redditID string
-
-	Account    *AccountService
-	Collection *CollectionService
-	Comment    *CommentService
-	Emoji      *EmojiService
-	Flair      *FlairService
-	Gold       *GoldService
-	Listings   *ListingsService
-	Message    *MessageService
-	Moderation *ModerationService
-	Multi      *MultiService
-	Post       *PostService
-	Stream     *StreamService
-	Subreddit  *SubredditService
-	User       *UserService
+	// This is the client's user ID in Reddit's database, without the "t2_" prefix.
+	// It's cached by Account.Info. The mutex also guards the username when it's
+	// filled in from the account's info.
+	redditIDMu sync.Mutex
+	redditID   string
+
+	Account    AccountAPI
+	Collection CollectionAPI
+	Comment    CommentAPI
+	Emoji      EmojiAPI
+	Flair      FlairAPI
+	Gold       GoldAPI
+	Listings   ListingsAPI
+	Message    MessageAPI
+	Moderation ModerationAPI
+	Multi      MultiAPI
+	Post       PostAPI
+	Stream     StreamAPI
+	Subreddit  SubredditAPI
+	User       UserAPI
 
 	oauth2Transport *oauth2.Transport
 
+	// Token obtained through the authorization code flow, if any.
+	token *oauth2.Token
+
+	// Used to persist access tokens, if set.
+	tokenStore TokenStore
+
+	// If true, the client authenticates as the app itself rather than a user.
+	// deviceID is only set for installed apps, which don't have a secret.
+	appOnly  bool
+	deviceID string
+
 	onRequestCompleted RequestCompletionCallback
+	interceptors       []Interceptor
+	instrumentation    Instrumentation
+
+	// Rate limit for the client, as determined by the most recent API call.
+	rateMu sync.Mutex
+	rate   Rate
+
+	// If true, requests will block until the rate limit resets
+	// instead of being sent when there are no requests remaining.
+	waitForRateLimit bool
+
+	retryPolicy *RetryPolicy
+
+	// Serves GET requests from a cache, if set.
+	cache *CacheConfig
+	// Full IDs of the subreddits seen by the cache, see subredditIDs.
+	cacheSubreddits subredditIDs
+
+	// If set, mutating requests aren't sent, but passed to this function instead.
+	dryRun func(*DryRunRequest)
+
+	// If set, records the outcome of every mutating request.
+	auditSink AuditSink
+
+	// If true, the client isn't authenticated and only makes GET requests
+	// to the public .json endpoints.
+	readOnly bool
 }
 
 // OnRequestCompleted sets the client's request completion callback.
+// It is called after the interceptors set via WithInterceptors.
 func (c *Client) OnRequestCompleted(rc RequestCompletionCallback) {
 	c.onRequestCompleted = rc
 }
@@ -129,11 +184,21 @@ func newClient(httpClient *http.Client) *Client {
 		httpClient = &http.Client{}
 	}
 
+	baseURL, _ := url.Parse(defaultBaseURL)
+	tokenURL, _ := url.Parse(defaultTokenURL)
+
+	client := &Client{client: httpClient, BaseURL: baseURL, TokenURL: tokenURL, instrumentation: NopInstrumentation{}}
+
 	// todo...
 	// Some endpoints (notably the ones to get random subreddits/posts) redirect to a
 	// reddit.com url, which returns a 403 Forbidden for some reason, unless the url's
 	// host is changed to oauth.reddit.com
 	httpClient.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		// read-only clients are meant to make requests to reddit.com
+		if client.readOnly {
+			return nil
+		}
+
 		redirectURL := req.URL.String()
 		redirectURL = strings.Replace(redirectURL, "https://www.reddit.com", defaultBaseURL, 1)
 
@@ -146,11 +211,6 @@ func newClient(httpClient *http.Client) *Client {
 		return nil
 	}
 
-	baseURL, _ := url.Parse(defaultBaseURL)
-	tokenURL, _ := url.Parse(defaultTokenURL)
-
-	client := &Client{client: httpClient, BaseURL: baseURL, TokenURL: tokenURL}
-
 	client.Account = &AccountService{client: client}
 	client.Collection = &CollectionService{client: client}
 	client.Emoji = &EmojiService{client: client}
@@ -164,9 +224,8 @@ func newClient(httpClient *http.Client) *Client {
 	client.Subreddit = &SubredditService{client: client}
 	client.User = &UserService{client: client}
 
-	postAndCommentService := &postAndCommentService{client: client}
-	client.Comment = &CommentService{client: client, postAndCommentService: postAndCommentService}
-	client.Post = &PostService{client: client, postAndCommentService: postAndCommentService}
+	client.Comment = &CommentService{client: client, postAndCommentService: &postAndCommentService{client: client, service: "Comment"}}
+	client.Post = &PostService{client: client, postAndCommentService: &postAndCommentService{client: client, service: "Post"}}
 
 	return client
 }
@@ -187,9 +246,42 @@ func NewClient(httpClient *http.Client, creds *Credentials, opts ...Opt) (*Clien
 		client.Secret = creds.Secret
 		client.Username = creds.Username
 		client.Password = creds.Password
+	}
+
+	// the token is refreshed with the app's credentials, so without them the first refresh would fail
+	if client.token != nil && client.token.RefreshToken != "" && client.ID == "" {
+		return nil, errors.New("creds: cannot be nil when the token has a refresh token")
+	}
 
-		oauthTransport := oauthTransport(client)
-		client.client.Transport = oauthTransport
+	if creds != nil || client.token != nil {
+		client.oauth2Transport = oauthTransport(client)
+		client.client.Transport = client.oauth2Transport
+	}
+
+	return client, nil
+}
+
+// NewReadonlyClient returns a new Reddit API client that isn't authenticated.
+// It can only read public data, by making GET requests to the .json endpoints of
+// www.reddit.com, e.g. https://www.reddit.com/r/golang/hot.json. Read methods such as
+// Subreddit.HotPosts, Post.Get and User.Get work the same way as with an authenticated
+// client, while the ones that make other types of requests return ErrReadOnly without
+// sending anything. If a nil httpClient is provided, a new http.Client will be used.
+func NewReadonlyClient(httpClient *http.Client, opts ...Opt) (*Client, error) {
+	client := newClient(httpClient)
+	client.BaseURL, _ = url.Parse(defaultBaseURLReadonly)
+	client.readOnly = true
+
+	for _, opt := range opts {
+		if err := opt(client); err != nil {
+			return nil, err
+		}
+	}
+
+	// Reddit is stricter with the default user agent of the stdlib
+	client.client.Transport = &userAgentTransport{
+		userAgent: client.UserAgent(),
+		Base:      client.client.Transport,
 	}
 
 	return client, nil
@@ -203,10 +295,38 @@ func (c *Client) UserAgent() string {
 	return c.userAgent
 }
 
+// RedditID returns the ID of the client's account, e.g. "abc123" (without the "t2_" prefix).
+// It's retrieved with Account.Info the first time, and cached afterwards.
+func (c *Client) RedditID(ctx context.Context) (string, error) {
+	c.redditIDMu.Lock()
+	id := c.redditID
+	c.redditIDMu.Unlock()
+	if id != "" {
+		return id, nil
+	}
+
+	user, _, err := c.Account.Info(ctx)
+	if err != nil {
+		return "", err
+	}
+	return user.ID, nil
+}
+
+func (c *Client) setRedditID(id string) {
+	c.redditIDMu.Lock()
+	defer c.redditIDMu.Unlock()
+	c.redditID = id
+}
+
 // NewRequest creates an API request.
 // The path is the relative URL which will be resolves to the BaseURL of the Client.
 // It should always be specified without a preceding slash.
 func (c *Client) NewRequest(method string, path string, body interface{}) (*http.Request, error) {
+	path, err := c.readOnlyPath(method, path)
+	if err != nil {
+		return nil, err
+	}
+
 	u, err := c.BaseURL.Parse(path)
 	if err != nil {
 		return nil, err
@@ -236,6 +356,11 @@ func (c *Client) NewRequest(method string, path string, body interface{}) (*http
 // The path is the relative URL which will be resolves to the BaseURL of the Client.
 // It should always be specified without a preceding slash.
 func (c *Client) NewRequestWithForm(method string, path string, form url.Values) (*http.Request, error) {
+	path, err := c.readOnlyPath(method, path)
+	if err != nil {
+		return nil, err
+	}
+
 	u, err := c.BaseURL.Parse(path)
 	if err != nil {
 		return nil, err
@@ -252,39 +377,249 @@ func (c *Client) NewRequestWithForm(method string, path string, form url.Values)
 	return req, nil
 }
 
-// Response is a PlayNetwork response. This wraps the standard http.Response returned from PlayNetwork.
+// readOnlyPath returns the path of the .json endpoint that read-only clients make requests to,
+// e.g. "r/golang/hot?limit=5" becomes "r/golang/hot.json?limit=5".
+// Only GET requests can be made by read-only clients.
+func (c *Client) readOnlyPath(method string, path string) (string, error) {
+	if !c.readOnly {
+		return path, nil
+	}
+
+	if method != http.MethodGet {
+		return "", ErrReadOnly
+	}
+
+	query := ""
+	if i := strings.Index(path, "?"); i >= 0 {
+		path, query = path[:i], path[i:]
+	}
+
+	path = strings.TrimSuffix(path, "/")
+	if !strings.HasSuffix(path, ".json") {
+		path += ".json"
+	}
+
+	return path + query, nil
+}
+
+// Response is a Reddit response. This wraps the standard http.Response returned from Reddit.
 type Response struct {
 	*http.Response
+
+	// Rate limit information for the request that produced this response.
+	Rate Rate
 }
 
 // newResponse creates a new Response for the provided http.Response.
 func newResponse(r *http.Response) *Response {
 	response := Response{Response: r}
+	response.Rate = parseRate(r)
 	return &response
 }
 
+// Rate represents the rate limit for the client.
+type Rate struct {
+	// The number of requests used in the current rate limit window.
+	Used int
+	// The number of requests remaining in the current rate limit window.
+	Remaining int
+	// The time at which the current rate limit window will reset.
+	Reset time.Time
+}
+
+// parseRate parses the rate limit headers of the response.
+// Reddit sends the number of seconds until the window resets, so we convert it to a time.
+func parseRate(r *http.Response) Rate {
+	var rate Rate
+	if v := r.Header.Get(headerRateLimitUsed); v != "" {
+		rate.Used, _ = strconv.Atoi(v)
+	}
+	if v := r.Header.Get(headerRateLimitRemaining); v != "" {
+		// this one comes as a float, e.g. 596.0
+		remaining, _ := strconv.ParseFloat(v, 64)
+		rate.Remaining = int(remaining)
+	}
+	if v := r.Header.Get(headerRateLimitReset); v != "" {
+		if seconds, err := strconv.Atoi(v); err == nil {
+			rate.Reset = time.Now().Truncate(time.Second).Add(time.Second * time.Duration(seconds))
+		}
+	}
+	return rate
+}
+
+// parseRetryAfter parses the Retry-After header of the response.
+// It can either be a number of seconds or an HTTP date.
+func parseRetryAfter(r *http.Response) time.Duration {
+	v := r.Header.Get(headerRetryAfter)
+	if v == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(v); err == nil {
+		return time.Second * time.Duration(seconds)
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+
+	return 0
+}
+
+// Rate returns the client's rate limit, as determined by the most recent API call.
+// If no call has been made yet, the zero value is returned.
+func (c *Client) Rate() Rate {
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+	return c.rate
+}
+
+func (c *Client) setRate(rate Rate) {
+	// responses that didn't come from the OAuth API don't have the rate limit headers
+	if rate.Reset.IsZero() {
+		return
+	}
+
+	c.rateMu.Lock()
+	defer c.rateMu.Unlock()
+	c.rate = rate
+}
+
+// waitForRate blocks until the rate limit window resets, if there are no requests remaining in it.
+func (c *Client) waitForRate(ctx context.Context) error {
+	rate := c.Rate()
+	if rate.Reset.IsZero() || rate.Remaining > 0 {
+		return nil
+	}
+
+	d := time.Until(rate.Reset)
+	if d <= 0 {
+		return nil
+	}
+
+	return sleep(ctx, d)
+}
+
+// sleep pauses the current goroutine for the duration d, or until the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
 // Do sends an API request and returns the API response. The API response is JSON decoded and stored in the value
 // pointed to by v, or returned as an error if an API error has occurred. If v implements the io.Writer interface,
 // the raw response will be written to v, without attempting to decode it.
+// If the client has a retry policy, requests failing with a retryable error are retried according to it.
 func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
-	resp, err := DoRequestWithClient(ctx, c.client, req)
-	if err != nil {
-		return nil, err
+	return c.doOperation(ctx, req, v, callerOperation())
+}
+
+// doOperation sends the API request for the operation. See Do for more information.
+func (c *Client) doOperation(ctx context.Context, req *http.Request, v interface{}, operation string) (*Response, error) {
+	info := &CallInfo{
+		Operation: operation,
+		Endpoint:  c.callEndpoint(req),
 	}
-	defer resp.Body.Close()
 
+	ctx, finish := c.instrumentation.StartCall(ctx, info)
+	start := time.Now()
+
+	resp, retries, err := c.doWithRetries(ctx, req, v, info)
+
+	stats := &CallStats{
+		Retries:  retries,
+		Duration: time.Since(start),
+		Err:      err,
+	}
+	if resp != nil {
+		stats.StatusCode = resp.StatusCode
+		stats.Rate = resp.Rate
+	}
+	finish(stats)
+
+	return resp, c.audit(req, info, resp, err)
+}
+
+// doWithRetries sends the request, retrying it according to the client's retry policy.
+// It returns the response of the last attempt, and the number of times the request was retried.
+func (c *Client) doWithRetries(ctx context.Context, req *http.Request, v interface{}, info *CallInfo) (*Response, int, error) {
+	for attempt := 0; ; attempt++ {
+		if c.waitForRateLimit {
+			if err := c.waitForRate(ctx); err != nil {
+				return nil, attempt, err
+			}
+		}
+
+		resp, err := c.do(ctx, req, v, info)
+		if !c.retryPolicy.shouldRetry(req, err, attempt) {
+			return resp, attempt, err
+		}
+
+		// don't bother waiting if we know the context will be done before the next attempt
+		backoff := c.retryPolicy.backoff(err, attempt)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < backoff {
+			return resp, attempt, err
+		}
+
+		if err := sleep(ctx, backoff); err != nil {
+			return resp, attempt, err
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return resp, attempt, err
+			}
+			req.Body = body
+		}
+	}
+}
+
+func (c *Client) do(ctx context.Context, req *http.Request, v interface{}, info *CallInfo) (*Response, error) {
+	interceptors := c.interceptors
+	if c.cache != nil {
+		// the cache is the outermost interceptor, so that responses served from it don't go through the others
+		interceptors = append([]Interceptor{cacheInterceptor(c.cache, &c.cacheSubreddits)}, interceptors...)
+	}
 	if c.onRequestCompleted != nil {
-		c.onRequestCompleted(req, resp)
+		// limit the capacity so that appending doesn't modify the client's slice
+		interceptors = append(interceptors[:len(interceptors):len(interceptors)], requestCompletedInterceptor(c.onRequestCompleted))
+	}
+
+	send := c.client.Do
+	dryRun := false
+	if c.dryRun != nil && isMutating(req.Method, info.Endpoint) {
+		send = func(req *http.Request) (*http.Response, error) {
+			dryRun = true
+			return c.dryRunResponse(req, info)
+		}
+	}
+
+	resp, err := chain(interceptors, info, send)(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
 	}
+	defer resp.Body.Close()
 
 	response := newResponse(resp)
+	c.setRate(response.Rate)
 
 	err = CheckResponse(resp)
 	if err != nil {
 		return response, err
 	}
 
-	if v != nil {
+	// synthetic responses of dry runs have nothing to decode
+	if v != nil && !dryRun {
 		if w, ok := v.(io.Writer); ok {
 			_, err = io.Copy(w, response.Body)
 			if err != nil {
@@ -301,19 +636,50 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 	return response, nil
 }
 
-// id returns the client's Reddit ID.
+// checkUserContext returns an error if the client isn't acting on behalf of a user.
+func (c *Client) checkUserContext() error {
+	if c.appOnly {
+		return ErrNoUserContext
+	}
+	return nil
+}
+
+// id returns the client's Reddit ID, as a full ID, e.g. "t2_abc123".
 func (c *Client) id(ctx context.Context) (string, *Response, error) {
-	if c.redditID != "" {
-		return c.redditID, nil, nil
+	if err := c.checkUserContext(); err != nil {
+		return "", nil, err
 	}
 
-	self, resp, err := c.User.Get(ctx, c.Username)
+	c.redditIDMu.Lock()
+	id, username := c.redditID, c.Username
+	c.redditIDMu.Unlock()
+
+	if id != "" {
+		return fmt.Sprintf("%s_%s", kindAccount, id), nil, nil
+	}
+
+	// clients authenticated with a token from the authorization code flow
+	// don't necessarily know the username they're acting on behalf of
+	if username == "" {
+		// caches the ID
+		self, resp, err := c.Account.Info(ctx)
+		if err != nil {
+			return "", resp, err
+		}
+
+		c.redditIDMu.Lock()
+		c.Username = self.Name
+		c.redditIDMu.Unlock()
+		return fmt.Sprintf("%s_%s", kindAccount, self.ID), resp, nil
+	}
+
+	self, resp, err := c.User.Get(ctx, username)
 	if err != nil {
 		return "", resp, err
 	}
 
-	c.redditID = fmt.Sprintf("%s_%s", kindAccount, self.ID)
-	return c.redditID, resp, nil
+	c.setRedditID(self.ID)
+	return fmt.Sprintf("%s_%s", kindAccount, self.ID), resp, nil
 }
 
 // DoRequest submits an HTTP request.
@@ -348,6 +714,24 @@ func CheckResponse(r *http.Response) error {
 		return nil
 	}
 
+	if r.StatusCode == http.StatusTooManyRequests {
+		rateLimitError := &RateLimitError{
+			Rate:       parseRate(r),
+			Response:   r,
+			RetryAfter: parseRetryAfter(r),
+		}
+		data, err = ioutil.ReadAll(r.Body)
+		if err == nil && len(data) > 0 {
+			errorResponse := new(ErrorResponse)
+			if err := json.Unmarshal(data, errorResponse); err == nil {
+				rateLimitError.Message = errorResponse.Message
+			} else {
+				rateLimitError.Message = string(data)
+			}
+		}
+		return rateLimitError
+	}
+
 	errorResponse := &ErrorResponse{Response: r}
 	data, err = ioutil.ReadAll(r.Body)
 	if err == nil && len(data) > 0 {
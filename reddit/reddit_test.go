@@ -10,6 +10,7 @@ import (
 	"os"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -184,3 +185,171 @@ func TestClient_ErrorResponse(t *testing.T) {
 	require.EqualError(t, err, fmt.Sprintf(`GET %s/api/v1/test: 403 error message`, client.BaseURL))
 	require.Equal(t, http.StatusForbidden, resp.StatusCode)
 }
+
+func TestClient_Rate(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		w.Header().Set(headerRateLimitUsed, "4")
+		w.Header().Set(headerRateLimitRemaining, "596.0")
+		w.Header().Set(headerRateLimitReset, "120")
+	})
+
+	require.Equal(t, Rate{}, client.Rate())
+
+	req, err := client.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+
+	resp, err := client.Do(ctx, req, nil)
+	require.NoError(t, err)
+	require.Equal(t, 4, resp.Rate.Used)
+	require.Equal(t, 596, resp.Rate.Remaining)
+	require.WithinDuration(t, time.Now().Add(time.Second*120), resp.Rate.Reset, time.Second*2)
+	require.Equal(t, resp.Rate, client.Rate())
+}
+
+func TestClient_Rate_NoHeaders(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+	})
+
+	req, err := client.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+
+	resp, err := client.Do(ctx, req, nil)
+	require.NoError(t, err)
+	require.Equal(t, Rate{}, resp.Rate)
+	require.Equal(t, Rate{}, client.Rate())
+}
+
+func TestClient_RateLimitWait(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	client.waitForRateLimit = true
+
+	var i int
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		i++
+		w.Header().Set(headerRateLimitUsed, "600")
+		w.Header().Set(headerRateLimitRemaining, "0.0")
+		w.Header().Set(headerRateLimitReset, "60")
+	})
+
+	req, err := client.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(ctx, req, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, i)
+
+	// the window only resets in a minute, so the request should wait until the context is done
+	ctx2, cancel := context.WithTimeout(ctx, time.Millisecond*50)
+	defer cancel()
+
+	_, err = client.Do(ctx2, req, nil)
+	require.Equal(t, context.DeadlineExceeded, err)
+	require.Equal(t, 1, i)
+}
+
+func TestClient_RateLimitError(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		w.Header().Set(headerRateLimitUsed, "600")
+		w.Header().Set(headerRateLimitRemaining, "0.0")
+		w.Header().Set(headerRateLimitReset, "90")
+		w.Header().Set(headerRetryAfter, "90")
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, `{
+			"message": "Too Many Requests",
+			"error": 429
+		}`)
+	})
+
+	req, err := client.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+
+	resp, err := client.Do(ctx, req, nil)
+	require.IsType(t, &RateLimitError{}, err)
+	require.EqualError(t, err, fmt.Sprintf(`GET %s/api/v1/test: 429 Too Many Requests [rate reset in 1m30s]`, client.BaseURL))
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+
+	rateLimitErr := err.(*RateLimitError)
+	require.Equal(t, time.Second*90, rateLimitErr.RetryAfter)
+	require.Equal(t, 600, rateLimitErr.Rate.Used)
+	require.Equal(t, 0, rateLimitErr.Rate.Remaining)
+}
+
+func TestNewReadonlyClient(t *testing.T) {
+	c, err := NewReadonlyClient(nil)
+	require.NoError(t, err)
+	require.Equal(t, "https://www.reddit.com", c.BaseURL.String())
+	testClientDefaults(t, c)
+
+	req, err := c.NewRequest(http.MethodGet, "r/golang/hot?limit=5", nil)
+	require.NoError(t, err)
+	require.Equal(t, "https://www.reddit.com/r/golang/hot.json?limit=5", req.URL.String())
+
+	req, err = c.NewRequest(http.MethodGet, "user/test/about/", nil)
+	require.NoError(t, err)
+	require.Equal(t, "https://www.reddit.com/user/test/about.json", req.URL.String())
+
+	_, err = c.NewRequest(http.MethodPost, "api/vote", nil)
+	require.Equal(t, ErrReadOnly, err)
+
+	_, err = c.NewRequestWithForm(http.MethodPost, "api/vote", nil)
+	require.Equal(t, ErrReadOnly, err)
+}
+
+func TestReadonlyClient(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := NewReadonlyClient(nil, WithBaseURL(server.URL))
+	require.NoError(t, err)
+
+	var requests int
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	mux.HandleFunc("/r/golang/hot.json", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Empty(t, r.Header.Get("Authorization"))
+		require.Contains(t, r.Header.Get(headerUserAgent), libraryName)
+		require.Equal(t, "5", r.URL.Query().Get("limit"))
+		fmt.Fprint(w, `{
+			"kind": "Listing",
+			"data": {
+				"children": [
+					{
+						"kind": "t3",
+						"data": {
+							"name": "t3_post1"
+						}
+					}
+				]
+			}
+		}`)
+	})
+
+	posts, _, err := client.Subreddit.HotPosts(ctx, "golang", &ListOptions{Limit: 5})
+	require.NoError(t, err)
+	require.Len(t, posts.Posts, 1)
+	require.Equal(t, "t3_post1", posts.Posts[0].FullID)
+
+	_, err = client.Post.Upvote(ctx, "t3_post1")
+	require.Equal(t, ErrReadOnly, err)
+	require.Equal(t, 0, requests)
+}
@@ -0,0 +1,69 @@
+// Package redditmock provides mocks of the service interfaces of the reddit package, for unit tests
+// of code built on it that don't need an HTTP server.
+//
+// The mocks record the calls made to them. Their behaviour is set through function fields:
+//
+//	posts := &redditmock.PostAPI{
+//		GetFunc: func(ctx context.Context, id string) (*reddit.PostAndComments, *reddit.Response, error) {
+//			return &reddit.PostAndComments{Post: &reddit.Post{ID: id}}, nil, nil
+//		},
+//	}
+//	client := &reddit.Client{Post: posts}
+//
+//	// ... code under test that calls client.Post.Get
+//
+//	calls := posts.CallsTo("Get")
+package redditmock
+
+import (
+	"sync"
+)
+
+// Call is a call made to a mock.
+type Call struct {
+	// Name of the method that was called, e.g. "Get".
+	Method string
+	// Arguments the method was called with.
+	Args []interface{}
+}
+
+// Recorder records the calls made to a mock.
+// It is safe for concurrent use.
+type Recorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Calls returns the calls made to the mock, in the order they were made.
+func (r *Recorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsTo returns the calls made to the method of the mock, in the order they were made.
+func (r *Recorder) CallsTo(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var calls []Call
+	for _, call := range r.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// Reset forgets the calls made to the mock.
+func (r *Recorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = nil
+}
+
+func (r *Recorder) record(method string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
@@ -0,0 +1,51 @@
+package redditmock
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vartanbeno/go-reddit/reddit"
+)
+
+var ctx = context.Background()
+
+func TestPostAPI(t *testing.T) {
+	posts := &PostAPI{
+		GetFunc: func(ctx context.Context, id string) (*reddit.PostAndComments, *reddit.Response, error) {
+			return &reddit.PostAndComments{Post: &reddit.Post{ID: id}}, nil, nil
+		},
+	}
+	client := &reddit.Client{Post: posts}
+
+	pc, _, err := client.Post.Get(ctx, "abc")
+	require.NoError(t, err)
+	require.Equal(t, "abc", pc.Post.ID)
+
+	// methods without a function return zero values
+	_, err = client.Post.Upvote(ctx, "t3_abc")
+	require.NoError(t, err)
+
+	require.Equal(t, []Call{
+		{Method: "Get", Args: []interface{}{ctx, "abc"}},
+		{Method: "Upvote", Args: []interface{}{ctx, "t3_abc"}},
+	}, posts.Calls())
+	require.Len(t, posts.CallsTo("Upvote"), 1)
+
+	posts.Reset()
+	require.Empty(t, posts.Calls())
+}
+
+func TestMessageAPI_Variadic(t *testing.T) {
+	messages := &MessageAPI{
+		ReadFunc: func(ctx context.Context, ids ...string) (*reddit.Response, error) {
+			require.Equal(t, []string{"t4_a", "t4_b"}, ids)
+			return nil, errors.New("error")
+		},
+	}
+
+	_, err := messages.Read(ctx, "t4_a", "t4_b")
+	require.EqualError(t, err, "error")
+	require.Equal(t, []interface{}{ctx, []string{"t4_a", "t4_b"}}, messages.CallsTo("Read")[0].Args)
+}
@@ -0,0 +1,2349 @@
+// Code generated by genapi. DO NOT EDIT.
+
+package redditmock
+
+import (
+	"context"
+
+	"github.com/vartanbeno/go-reddit/reddit"
+)
+
+// AccountAPI is a mock of reddit.AccountAPI.
+// Its methods record their calls, and then call the function field of the same name (e.g. GetFunc for Get),
+// if set. Otherwise, they return zero values.
+type AccountAPI struct {
+	Recorder
+
+	AddTrustedFunc         func(ctx context.Context, username string) (*reddit.Response, error)
+	BlockedFunc            func(ctx context.Context) ([]reddit.Relationship, *reddit.Response, error)
+	FriendsFunc            func(ctx context.Context) ([]reddit.Relationship, *reddit.Response, error)
+	InfoFunc               func(ctx context.Context) (*reddit.User, *reddit.Response, error)
+	KarmaFunc              func(ctx context.Context) ([]reddit.SubredditKarma, *reddit.Response, error)
+	MessagingFunc          func(ctx context.Context) ([]reddit.Relationship, []reddit.Relationship, *reddit.Response, error)
+	RemoveTrustedFunc      func(ctx context.Context, username string) (*reddit.Response, error)
+	RequireScopesFunc      func(ctx context.Context, scopes ...string) error
+	RevokeAccessTokenFunc  func(ctx context.Context, token string) (*reddit.Response, error)
+	RevokeRefreshTokenFunc func(ctx context.Context, token string) (*reddit.Response, error)
+	ScopeDescriptionsFunc  func(ctx context.Context, scopes ...string) (map[string]*reddit.Scope, *reddit.Response, error)
+	ScopesFunc             func(ctx context.Context) (reddit.Scopes, error)
+	SettingsFunc           func(ctx context.Context) (*reddit.Settings, *reddit.Response, error)
+	TrophiesFunc           func(ctx context.Context) ([]reddit.Trophy, *reddit.Response, error)
+	TrustedFunc            func(ctx context.Context) ([]reddit.Relationship, *reddit.Response, error)
+	UpdateSettingsFunc     func(ctx context.Context, settings *reddit.Settings) (*reddit.Settings, *reddit.Response, error)
+}
+
+var _ reddit.AccountAPI = &AccountAPI{}
+
+// AddTrusted records the call, and calls AddTrustedFunc if it is set.
+func (m *AccountAPI) AddTrusted(ctx context.Context, username string) (r0 *reddit.Response, r1 error) {
+	m.record("AddTrusted", ctx, username)
+	if m.AddTrustedFunc != nil {
+		return m.AddTrustedFunc(ctx, username)
+	}
+	return
+}
+
+// Blocked records the call, and calls BlockedFunc if it is set.
+func (m *AccountAPI) Blocked(ctx context.Context) (r0 []reddit.Relationship, r1 *reddit.Response, r2 error) {
+	m.record("Blocked", ctx)
+	if m.BlockedFunc != nil {
+		return m.BlockedFunc(ctx)
+	}
+	return
+}
+
+// Friends records the call, and calls FriendsFunc if it is set.
+func (m *AccountAPI) Friends(ctx context.Context) (r0 []reddit.Relationship, r1 *reddit.Response, r2 error) {
+	m.record("Friends", ctx)
+	if m.FriendsFunc != nil {
+		return m.FriendsFunc(ctx)
+	}
+	return
+}
+
+// Info records the call, and calls InfoFunc if it is set.
+func (m *AccountAPI) Info(ctx context.Context) (r0 *reddit.User, r1 *reddit.Response, r2 error) {
+	m.record("Info", ctx)
+	if m.InfoFunc != nil {
+		return m.InfoFunc(ctx)
+	}
+	return
+}
+
+// Karma records the call, and calls KarmaFunc if it is set.
+func (m *AccountAPI) Karma(ctx context.Context) (r0 []reddit.SubredditKarma, r1 *reddit.Response, r2 error) {
+	m.record("Karma", ctx)
+	if m.KarmaFunc != nil {
+		return m.KarmaFunc(ctx)
+	}
+	return
+}
+
+// Messaging records the call, and calls MessagingFunc if it is set.
+func (m *AccountAPI) Messaging(ctx context.Context) (r0 []reddit.Relationship, r1 []reddit.Relationship, r2 *reddit.Response, r3 error) {
+	m.record("Messaging", ctx)
+	if m.MessagingFunc != nil {
+		return m.MessagingFunc(ctx)
+	}
+	return
+}
+
+// RemoveTrusted records the call, and calls RemoveTrustedFunc if it is set.
+func (m *AccountAPI) RemoveTrusted(ctx context.Context, username string) (r0 *reddit.Response, r1 error) {
+	m.record("RemoveTrusted", ctx, username)
+	if m.RemoveTrustedFunc != nil {
+		return m.RemoveTrustedFunc(ctx, username)
+	}
+	return
+}
+
+// RequireScopes records the call, and calls RequireScopesFunc if it is set.
+func (m *AccountAPI) RequireScopes(ctx context.Context, scopes ...string) (r0 error) {
+	m.record("RequireScopes", ctx, scopes)
+	if m.RequireScopesFunc != nil {
+		return m.RequireScopesFunc(ctx, scopes...)
+	}
+	return
+}
+
+// RevokeAccessToken records the call, and calls RevokeAccessTokenFunc if it is set.
+func (m *AccountAPI) RevokeAccessToken(ctx context.Context, token string) (r0 *reddit.Response, r1 error) {
+	m.record("RevokeAccessToken", ctx, token)
+	if m.RevokeAccessTokenFunc != nil {
+		return m.RevokeAccessTokenFunc(ctx, token)
+	}
+	return
+}
+
+// RevokeRefreshToken records the call, and calls RevokeRefreshTokenFunc if it is set.
+func (m *AccountAPI) RevokeRefreshToken(ctx context.Context, token string) (r0 *reddit.Response, r1 error) {
+	m.record("RevokeRefreshToken", ctx, token)
+	if m.RevokeRefreshTokenFunc != nil {
+		return m.RevokeRefreshTokenFunc(ctx, token)
+	}
+	return
+}
+
+// ScopeDescriptions records the call, and calls ScopeDescriptionsFunc if it is set.
+func (m *AccountAPI) ScopeDescriptions(ctx context.Context, scopes ...string) (r0 map[string]*reddit.Scope, r1 *reddit.Response, r2 error) {
+	m.record("ScopeDescriptions", ctx, scopes)
+	if m.ScopeDescriptionsFunc != nil {
+		return m.ScopeDescriptionsFunc(ctx, scopes...)
+	}
+	return
+}
+
+// Scopes records the call, and calls ScopesFunc if it is set.
+func (m *AccountAPI) Scopes(ctx context.Context) (r0 reddit.Scopes, r1 error) {
+	m.record("Scopes", ctx)
+	if m.ScopesFunc != nil {
+		return m.ScopesFunc(ctx)
+	}
+	return
+}
+
+// Settings records the call, and calls SettingsFunc if it is set.
+func (m *AccountAPI) Settings(ctx context.Context) (r0 *reddit.Settings, r1 *reddit.Response, r2 error) {
+	m.record("Settings", ctx)
+	if m.SettingsFunc != nil {
+		return m.SettingsFunc(ctx)
+	}
+	return
+}
+
+// Trophies records the call, and calls TrophiesFunc if it is set.
+func (m *AccountAPI) Trophies(ctx context.Context) (r0 []reddit.Trophy, r1 *reddit.Response, r2 error) {
+	m.record("Trophies", ctx)
+	if m.TrophiesFunc != nil {
+		return m.TrophiesFunc(ctx)
+	}
+	return
+}
+
+// Trusted records the call, and calls TrustedFunc if it is set.
+func (m *AccountAPI) Trusted(ctx context.Context) (r0 []reddit.Relationship, r1 *reddit.Response, r2 error) {
+	m.record("Trusted", ctx)
+	if m.TrustedFunc != nil {
+		return m.TrustedFunc(ctx)
+	}
+	return
+}
+
+// UpdateSettings records the call, and calls UpdateSettingsFunc if it is set.
+func (m *AccountAPI) UpdateSettings(ctx context.Context, settings *reddit.Settings) (r0 *reddit.Settings, r1 *reddit.Response, r2 error) {
+	m.record("UpdateSettings", ctx, settings)
+	if m.UpdateSettingsFunc != nil {
+		return m.UpdateSettingsFunc(ctx, settings)
+	}
+	return
+}
+
+// CollectionAPI is a mock of reddit.CollectionAPI.
+// Its methods record their calls, and then call the function field of the same name (e.g. GetFunc for Get),
+// if set. Otherwise, they return zero values.
+type CollectionAPI struct {
+	Recorder
+
+	AddPostFunc              func(ctx context.Context, postID, collectionID string) (*reddit.Response, error)
+	CreateFunc               func(ctx context.Context, createRequest *reddit.CollectionCreateRequest) (*reddit.Collection, *reddit.Response, error)
+	DeleteFunc               func(ctx context.Context, id string) (*reddit.Response, error)
+	FollowFunc               func(ctx context.Context, id string) (*reddit.Response, error)
+	FromSubredditFunc        func(ctx context.Context, id string) ([]*reddit.Collection, *reddit.Response, error)
+	GetFunc                  func(ctx context.Context, id string) (*reddit.Collection, *reddit.Response, error)
+	RemovePostFunc           func(ctx context.Context, postID, collectionID string) (*reddit.Response, error)
+	ReorderPostsFunc         func(ctx context.Context, collectionID string, postIDs ...string) (*reddit.Response, error)
+	UnfollowFunc             func(ctx context.Context, id string) (*reddit.Response, error)
+	UpdateDescriptionFunc    func(ctx context.Context, id string, description string) (*reddit.Response, error)
+	UpdateLayoutGalleryFunc  func(ctx context.Context, id string) (*reddit.Response, error)
+	UpdateLayoutTimelineFunc func(ctx context.Context, id string) (*reddit.Response, error)
+	UpdateTitleFunc          func(ctx context.Context, id string, title string) (*reddit.Response, error)
+}
+
+var _ reddit.CollectionAPI = &CollectionAPI{}
+
+// AddPost records the call, and calls AddPostFunc if it is set.
+func (m *CollectionAPI) AddPost(ctx context.Context, postID string, collectionID string) (r0 *reddit.Response, r1 error) {
+	m.record("AddPost", ctx, postID, collectionID)
+	if m.AddPostFunc != nil {
+		return m.AddPostFunc(ctx, postID, collectionID)
+	}
+	return
+}
+
+// Create records the call, and calls CreateFunc if it is set.
+func (m *CollectionAPI) Create(ctx context.Context, createRequest *reddit.CollectionCreateRequest) (r0 *reddit.Collection, r1 *reddit.Response, r2 error) {
+	m.record("Create", ctx, createRequest)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, createRequest)
+	}
+	return
+}
+
+// Delete records the call, and calls DeleteFunc if it is set.
+func (m *CollectionAPI) Delete(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("Delete", ctx, id)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return
+}
+
+// Follow records the call, and calls FollowFunc if it is set.
+func (m *CollectionAPI) Follow(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("Follow", ctx, id)
+	if m.FollowFunc != nil {
+		return m.FollowFunc(ctx, id)
+	}
+	return
+}
+
+// FromSubreddit records the call, and calls FromSubredditFunc if it is set.
+func (m *CollectionAPI) FromSubreddit(ctx context.Context, id string) (r0 []*reddit.Collection, r1 *reddit.Response, r2 error) {
+	m.record("FromSubreddit", ctx, id)
+	if m.FromSubredditFunc != nil {
+		return m.FromSubredditFunc(ctx, id)
+	}
+	return
+}
+
+// Get records the call, and calls GetFunc if it is set.
+func (m *CollectionAPI) Get(ctx context.Context, id string) (r0 *reddit.Collection, r1 *reddit.Response, r2 error) {
+	m.record("Get", ctx, id)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id)
+	}
+	return
+}
+
+// RemovePost records the call, and calls RemovePostFunc if it is set.
+func (m *CollectionAPI) RemovePost(ctx context.Context, postID string, collectionID string) (r0 *reddit.Response, r1 error) {
+	m.record("RemovePost", ctx, postID, collectionID)
+	if m.RemovePostFunc != nil {
+		return m.RemovePostFunc(ctx, postID, collectionID)
+	}
+	return
+}
+
+// ReorderPosts records the call, and calls ReorderPostsFunc if it is set.
+func (m *CollectionAPI) ReorderPosts(ctx context.Context, collectionID string, postIDs ...string) (r0 *reddit.Response, r1 error) {
+	m.record("ReorderPosts", ctx, collectionID, postIDs)
+	if m.ReorderPostsFunc != nil {
+		return m.ReorderPostsFunc(ctx, collectionID, postIDs...)
+	}
+	return
+}
+
+// Unfollow records the call, and calls UnfollowFunc if it is set.
+func (m *CollectionAPI) Unfollow(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("Unfollow", ctx, id)
+	if m.UnfollowFunc != nil {
+		return m.UnfollowFunc(ctx, id)
+	}
+	return
+}
+
+// UpdateDescription records the call, and calls UpdateDescriptionFunc if it is set.
+func (m *CollectionAPI) UpdateDescription(ctx context.Context, id string, description string) (r0 *reddit.Response, r1 error) {
+	m.record("UpdateDescription", ctx, id, description)
+	if m.UpdateDescriptionFunc != nil {
+		return m.UpdateDescriptionFunc(ctx, id, description)
+	}
+	return
+}
+
+// UpdateLayoutGallery records the call, and calls UpdateLayoutGalleryFunc if it is set.
+func (m *CollectionAPI) UpdateLayoutGallery(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("UpdateLayoutGallery", ctx, id)
+	if m.UpdateLayoutGalleryFunc != nil {
+		return m.UpdateLayoutGalleryFunc(ctx, id)
+	}
+	return
+}
+
+// UpdateLayoutTimeline records the call, and calls UpdateLayoutTimelineFunc if it is set.
+func (m *CollectionAPI) UpdateLayoutTimeline(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("UpdateLayoutTimeline", ctx, id)
+	if m.UpdateLayoutTimelineFunc != nil {
+		return m.UpdateLayoutTimelineFunc(ctx, id)
+	}
+	return
+}
+
+// UpdateTitle records the call, and calls UpdateTitleFunc if it is set.
+func (m *CollectionAPI) UpdateTitle(ctx context.Context, id string, title string) (r0 *reddit.Response, r1 error) {
+	m.record("UpdateTitle", ctx, id, title)
+	if m.UpdateTitleFunc != nil {
+		return m.UpdateTitleFunc(ctx, id, title)
+	}
+	return
+}
+
+// CommentAPI is a mock of reddit.CommentAPI.
+// Its methods record their calls, and then call the function field of the same name (e.g. GetFunc for Get),
+// if set. Otherwise, they return zero values.
+type CommentAPI struct {
+	Recorder
+
+	DeleteFunc          func(ctx context.Context, id string) (*reddit.Response, error)
+	DisableRepliesFunc  func(ctx context.Context, id string) (*reddit.Response, error)
+	DownvoteFunc        func(ctx context.Context, id string) (*reddit.Response, error)
+	EditFunc            func(ctx context.Context, id string, text string) (*reddit.Comment, *reddit.Response, error)
+	EnableRepliesFunc   func(ctx context.Context, id string) (*reddit.Response, error)
+	LoadMoreRepliesFunc func(ctx context.Context, comment *reddit.Comment) (*reddit.Response, error)
+	LockFunc            func(ctx context.Context, id string) (*reddit.Response, error)
+	RemoveVoteFunc      func(ctx context.Context, id string) (*reddit.Response, error)
+	ReportFunc          func(ctx context.Context, id string, reason string) (*reddit.Response, error)
+	SaveFunc            func(ctx context.Context, id string) (*reddit.Response, error)
+	SubmitFunc          func(ctx context.Context, parentID string, text string) (*reddit.Comment, *reddit.Response, error)
+	UnlockFunc          func(ctx context.Context, id string) (*reddit.Response, error)
+	UnsaveFunc          func(ctx context.Context, id string) (*reddit.Response, error)
+	UpvoteFunc          func(ctx context.Context, id string) (*reddit.Response, error)
+}
+
+var _ reddit.CommentAPI = &CommentAPI{}
+
+// Delete records the call, and calls DeleteFunc if it is set.
+func (m *CommentAPI) Delete(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("Delete", ctx, id)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return
+}
+
+// DisableReplies records the call, and calls DisableRepliesFunc if it is set.
+func (m *CommentAPI) DisableReplies(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("DisableReplies", ctx, id)
+	if m.DisableRepliesFunc != nil {
+		return m.DisableRepliesFunc(ctx, id)
+	}
+	return
+}
+
+// Downvote records the call, and calls DownvoteFunc if it is set.
+func (m *CommentAPI) Downvote(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("Downvote", ctx, id)
+	if m.DownvoteFunc != nil {
+		return m.DownvoteFunc(ctx, id)
+	}
+	return
+}
+
+// Edit records the call, and calls EditFunc if it is set.
+func (m *CommentAPI) Edit(ctx context.Context, id string, text string) (r0 *reddit.Comment, r1 *reddit.Response, r2 error) {
+	m.record("Edit", ctx, id, text)
+	if m.EditFunc != nil {
+		return m.EditFunc(ctx, id, text)
+	}
+	return
+}
+
+// EnableReplies records the call, and calls EnableRepliesFunc if it is set.
+func (m *CommentAPI) EnableReplies(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("EnableReplies", ctx, id)
+	if m.EnableRepliesFunc != nil {
+		return m.EnableRepliesFunc(ctx, id)
+	}
+	return
+}
+
+// LoadMoreReplies records the call, and calls LoadMoreRepliesFunc if it is set.
+func (m *CommentAPI) LoadMoreReplies(ctx context.Context, comment *reddit.Comment) (r0 *reddit.Response, r1 error) {
+	m.record("LoadMoreReplies", ctx, comment)
+	if m.LoadMoreRepliesFunc != nil {
+		return m.LoadMoreRepliesFunc(ctx, comment)
+	}
+	return
+}
+
+// Lock records the call, and calls LockFunc if it is set.
+func (m *CommentAPI) Lock(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("Lock", ctx, id)
+	if m.LockFunc != nil {
+		return m.LockFunc(ctx, id)
+	}
+	return
+}
+
+// RemoveVote records the call, and calls RemoveVoteFunc if it is set.
+func (m *CommentAPI) RemoveVote(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("RemoveVote", ctx, id)
+	if m.RemoveVoteFunc != nil {
+		return m.RemoveVoteFunc(ctx, id)
+	}
+	return
+}
+
+// Report records the call, and calls ReportFunc if it is set.
+func (m *CommentAPI) Report(ctx context.Context, id string, reason string) (r0 *reddit.Response, r1 error) {
+	m.record("Report", ctx, id, reason)
+	if m.ReportFunc != nil {
+		return m.ReportFunc(ctx, id, reason)
+	}
+	return
+}
+
+// Save records the call, and calls SaveFunc if it is set.
+func (m *CommentAPI) Save(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("Save", ctx, id)
+	if m.SaveFunc != nil {
+		return m.SaveFunc(ctx, id)
+	}
+	return
+}
+
+// Submit records the call, and calls SubmitFunc if it is set.
+func (m *CommentAPI) Submit(ctx context.Context, parentID string, text string) (r0 *reddit.Comment, r1 *reddit.Response, r2 error) {
+	m.record("Submit", ctx, parentID, text)
+	if m.SubmitFunc != nil {
+		return m.SubmitFunc(ctx, parentID, text)
+	}
+	return
+}
+
+// Unlock records the call, and calls UnlockFunc if it is set.
+func (m *CommentAPI) Unlock(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("Unlock", ctx, id)
+	if m.UnlockFunc != nil {
+		return m.UnlockFunc(ctx, id)
+	}
+	return
+}
+
+// Unsave records the call, and calls UnsaveFunc if it is set.
+func (m *CommentAPI) Unsave(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("Unsave", ctx, id)
+	if m.UnsaveFunc != nil {
+		return m.UnsaveFunc(ctx, id)
+	}
+	return
+}
+
+// Upvote records the call, and calls UpvoteFunc if it is set.
+func (m *CommentAPI) Upvote(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("Upvote", ctx, id)
+	if m.UpvoteFunc != nil {
+		return m.UpvoteFunc(ctx, id)
+	}
+	return
+}
+
+// EmojiAPI is a mock of reddit.EmojiAPI.
+// Its methods record their calls, and then call the function field of the same name (e.g. GetFunc for Get),
+// if set. Otherwise, they return zero values.
+type EmojiAPI struct {
+	Recorder
+
+	DeleteFunc            func(ctx context.Context, subreddit string, emoji string) (*reddit.Response, error)
+	DisableCustomSizeFunc func(ctx context.Context, subreddit string) (*reddit.Response, error)
+	GetFunc               func(ctx context.Context, subreddit string) ([]*reddit.Emoji, []*reddit.Emoji, *reddit.Response, error)
+	SetSizeFunc           func(ctx context.Context, subreddit string, height, width int) (*reddit.Response, error)
+	UpdateFunc            func(ctx context.Context, subreddit string, updateRequest *reddit.EmojiCreateOrUpdateRequest) (*reddit.Response, error)
+	UploadFunc            func(ctx context.Context, subreddit string, createRequest *reddit.EmojiCreateOrUpdateRequest, imagePath string) (*reddit.Response, error)
+}
+
+var _ reddit.EmojiAPI = &EmojiAPI{}
+
+// Delete records the call, and calls DeleteFunc if it is set.
+func (m *EmojiAPI) Delete(ctx context.Context, subreddit string, emoji string) (r0 *reddit.Response, r1 error) {
+	m.record("Delete", ctx, subreddit, emoji)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, subreddit, emoji)
+	}
+	return
+}
+
+// DisableCustomSize records the call, and calls DisableCustomSizeFunc if it is set.
+func (m *EmojiAPI) DisableCustomSize(ctx context.Context, subreddit string) (r0 *reddit.Response, r1 error) {
+	m.record("DisableCustomSize", ctx, subreddit)
+	if m.DisableCustomSizeFunc != nil {
+		return m.DisableCustomSizeFunc(ctx, subreddit)
+	}
+	return
+}
+
+// Get records the call, and calls GetFunc if it is set.
+func (m *EmojiAPI) Get(ctx context.Context, subreddit string) (r0 []*reddit.Emoji, r1 []*reddit.Emoji, r2 *reddit.Response, r3 error) {
+	m.record("Get", ctx, subreddit)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, subreddit)
+	}
+	return
+}
+
+// SetSize records the call, and calls SetSizeFunc if it is set.
+func (m *EmojiAPI) SetSize(ctx context.Context, subreddit string, height int, width int) (r0 *reddit.Response, r1 error) {
+	m.record("SetSize", ctx, subreddit, height, width)
+	if m.SetSizeFunc != nil {
+		return m.SetSizeFunc(ctx, subreddit, height, width)
+	}
+	return
+}
+
+// Update records the call, and calls UpdateFunc if it is set.
+func (m *EmojiAPI) Update(ctx context.Context, subreddit string, updateRequest *reddit.EmojiCreateOrUpdateRequest) (r0 *reddit.Response, r1 error) {
+	m.record("Update", ctx, subreddit, updateRequest)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, subreddit, updateRequest)
+	}
+	return
+}
+
+// Upload records the call, and calls UploadFunc if it is set.
+func (m *EmojiAPI) Upload(ctx context.Context, subreddit string, createRequest *reddit.EmojiCreateOrUpdateRequest, imagePath string) (r0 *reddit.Response, r1 error) {
+	m.record("Upload", ctx, subreddit, createRequest, imagePath)
+	if m.UploadFunc != nil {
+		return m.UploadFunc(ctx, subreddit, createRequest, imagePath)
+	}
+	return
+}
+
+// FlairAPI is a mock of reddit.FlairAPI.
+// Its methods record their calls, and then call the function field of the same name (e.g. GetFunc for Get),
+// if set. Otherwise, they return zero values.
+type FlairAPI struct {
+	Recorder
+
+	GetPostFlairsFunc  func(ctx context.Context, subreddit string) ([]*reddit.Flair, *reddit.Response, error)
+	GetUserFlairsFunc  func(ctx context.Context, subreddit string) ([]*reddit.Flair, *reddit.Response, error)
+	ListUserFlairsFunc func(ctx context.Context, subreddit string) ([]*reddit.FlairSummary, *reddit.Response, error)
+}
+
+var _ reddit.FlairAPI = &FlairAPI{}
+
+// GetPostFlairs records the call, and calls GetPostFlairsFunc if it is set.
+func (m *FlairAPI) GetPostFlairs(ctx context.Context, subreddit string) (r0 []*reddit.Flair, r1 *reddit.Response, r2 error) {
+	m.record("GetPostFlairs", ctx, subreddit)
+	if m.GetPostFlairsFunc != nil {
+		return m.GetPostFlairsFunc(ctx, subreddit)
+	}
+	return
+}
+
+// GetUserFlairs records the call, and calls GetUserFlairsFunc if it is set.
+func (m *FlairAPI) GetUserFlairs(ctx context.Context, subreddit string) (r0 []*reddit.Flair, r1 *reddit.Response, r2 error) {
+	m.record("GetUserFlairs", ctx, subreddit)
+	if m.GetUserFlairsFunc != nil {
+		return m.GetUserFlairsFunc(ctx, subreddit)
+	}
+	return
+}
+
+// ListUserFlairs records the call, and calls ListUserFlairsFunc if it is set.
+func (m *FlairAPI) ListUserFlairs(ctx context.Context, subreddit string) (r0 []*reddit.FlairSummary, r1 *reddit.Response, r2 error) {
+	m.record("ListUserFlairs", ctx, subreddit)
+	if m.ListUserFlairsFunc != nil {
+		return m.ListUserFlairsFunc(ctx, subreddit)
+	}
+	return
+}
+
+// GoldAPI is a mock of reddit.GoldAPI.
+// Its methods record their calls, and then call the function field of the same name (e.g. GetFunc for Get),
+// if set. Otherwise, they return zero values.
+type GoldAPI struct {
+	Recorder
+
+	GildFunc func(ctx context.Context, id string) (*reddit.Response, error)
+	GiveFunc func(ctx context.Context, username string, months int) (*reddit.Response, error)
+}
+
+var _ reddit.GoldAPI = &GoldAPI{}
+
+// Gild records the call, and calls GildFunc if it is set.
+func (m *GoldAPI) Gild(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("Gild", ctx, id)
+	if m.GildFunc != nil {
+		return m.GildFunc(ctx, id)
+	}
+	return
+}
+
+// Give records the call, and calls GiveFunc if it is set.
+func (m *GoldAPI) Give(ctx context.Context, username string, months int) (r0 *reddit.Response, r1 error) {
+	m.record("Give", ctx, username, months)
+	if m.GiveFunc != nil {
+		return m.GiveFunc(ctx, username, months)
+	}
+	return
+}
+
+// ListingsAPI is a mock of reddit.ListingsAPI.
+// Its methods record their calls, and then call the function field of the same name (e.g. GetFunc for Get),
+// if set. Otherwise, they return zero values.
+type ListingsAPI struct {
+	Recorder
+
+	GetFunc                 func(ctx context.Context, ids ...string) ([]*reddit.Post, []*reddit.Comment, []*reddit.Subreddit, *reddit.Response, error)
+	GetPostsFunc            func(ctx context.Context, ids ...string) ([]*reddit.Post, *reddit.Response, error)
+	GetPostsByURLFunc       func(ctx context.Context, link string, opts *reddit.ListOptions) (*reddit.Posts, *reddit.Response, error)
+	GetSubredditsByNameFunc func(ctx context.Context, names ...string) ([]*reddit.Subreddit, *reddit.Response, error)
+}
+
+var _ reddit.ListingsAPI = &ListingsAPI{}
+
+// Get records the call, and calls GetFunc if it is set.
+func (m *ListingsAPI) Get(ctx context.Context, ids ...string) (r0 []*reddit.Post, r1 []*reddit.Comment, r2 []*reddit.Subreddit, r3 *reddit.Response, r4 error) {
+	m.record("Get", ctx, ids)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, ids...)
+	}
+	return
+}
+
+// GetPosts records the call, and calls GetPostsFunc if it is set.
+func (m *ListingsAPI) GetPosts(ctx context.Context, ids ...string) (r0 []*reddit.Post, r1 *reddit.Response, r2 error) {
+	m.record("GetPosts", ctx, ids)
+	if m.GetPostsFunc != nil {
+		return m.GetPostsFunc(ctx, ids...)
+	}
+	return
+}
+
+// GetPostsByURL records the call, and calls GetPostsByURLFunc if it is set.
+func (m *ListingsAPI) GetPostsByURL(ctx context.Context, link string, opts *reddit.ListOptions) (r0 *reddit.Posts, r1 *reddit.Response, r2 error) {
+	m.record("GetPostsByURL", ctx, link, opts)
+	if m.GetPostsByURLFunc != nil {
+		return m.GetPostsByURLFunc(ctx, link, opts)
+	}
+	return
+}
+
+// GetSubredditsByName records the call, and calls GetSubredditsByNameFunc if it is set.
+func (m *ListingsAPI) GetSubredditsByName(ctx context.Context, names ...string) (r0 []*reddit.Subreddit, r1 *reddit.Response, r2 error) {
+	m.record("GetSubredditsByName", ctx, names)
+	if m.GetSubredditsByNameFunc != nil {
+		return m.GetSubredditsByNameFunc(ctx, names...)
+	}
+	return
+}
+
+// MessageAPI is a mock of reddit.MessageAPI.
+// Its methods record their calls, and then call the function field of the same name (e.g. GetFunc for Get),
+// if set. Otherwise, they return zero values.
+type MessageAPI struct {
+	Recorder
+
+	BlockFunc       func(ctx context.Context, id string) (*reddit.Response, error)
+	CollapseFunc    func(ctx context.Context, ids ...string) (*reddit.Response, error)
+	DeleteFunc      func(ctx context.Context, id string) (*reddit.Response, error)
+	InboxFunc       func(ctx context.Context, opts *reddit.ListOptions) (*reddit.Messages, *reddit.Messages, *reddit.Response, error)
+	InboxUnreadFunc func(ctx context.Context, opts *reddit.ListOptions) (*reddit.Messages, *reddit.Messages, *reddit.Response, error)
+	ReadFunc        func(ctx context.Context, ids ...string) (*reddit.Response, error)
+	ReadAllFunc     func(ctx context.Context) (*reddit.Response, error)
+	SendFunc        func(ctx context.Context, sendRequest *reddit.SendMessageRequest) (*reddit.Response, error)
+	SentFunc        func(ctx context.Context, opts *reddit.ListOptions) (*reddit.Messages, *reddit.Response, error)
+	UncollapseFunc  func(ctx context.Context, ids ...string) (*reddit.Response, error)
+	UnreadFunc      func(ctx context.Context, ids ...string) (*reddit.Response, error)
+}
+
+var _ reddit.MessageAPI = &MessageAPI{}
+
+// Block records the call, and calls BlockFunc if it is set.
+func (m *MessageAPI) Block(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("Block", ctx, id)
+	if m.BlockFunc != nil {
+		return m.BlockFunc(ctx, id)
+	}
+	return
+}
+
+// Collapse records the call, and calls CollapseFunc if it is set.
+func (m *MessageAPI) Collapse(ctx context.Context, ids ...string) (r0 *reddit.Response, r1 error) {
+	m.record("Collapse", ctx, ids)
+	if m.CollapseFunc != nil {
+		return m.CollapseFunc(ctx, ids...)
+	}
+	return
+}
+
+// Delete records the call, and calls DeleteFunc if it is set.
+func (m *MessageAPI) Delete(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("Delete", ctx, id)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return
+}
+
+// Inbox records the call, and calls InboxFunc if it is set.
+func (m *MessageAPI) Inbox(ctx context.Context, opts *reddit.ListOptions) (r0 *reddit.Messages, r1 *reddit.Messages, r2 *reddit.Response, r3 error) {
+	m.record("Inbox", ctx, opts)
+	if m.InboxFunc != nil {
+		return m.InboxFunc(ctx, opts)
+	}
+	return
+}
+
+// InboxUnread records the call, and calls InboxUnreadFunc if it is set.
+func (m *MessageAPI) InboxUnread(ctx context.Context, opts *reddit.ListOptions) (r0 *reddit.Messages, r1 *reddit.Messages, r2 *reddit.Response, r3 error) {
+	m.record("InboxUnread", ctx, opts)
+	if m.InboxUnreadFunc != nil {
+		return m.InboxUnreadFunc(ctx, opts)
+	}
+	return
+}
+
+// Read records the call, and calls ReadFunc if it is set.
+func (m *MessageAPI) Read(ctx context.Context, ids ...string) (r0 *reddit.Response, r1 error) {
+	m.record("Read", ctx, ids)
+	if m.ReadFunc != nil {
+		return m.ReadFunc(ctx, ids...)
+	}
+	return
+}
+
+// ReadAll records the call, and calls ReadAllFunc if it is set.
+func (m *MessageAPI) ReadAll(ctx context.Context) (r0 *reddit.Response, r1 error) {
+	m.record("ReadAll", ctx)
+	if m.ReadAllFunc != nil {
+		return m.ReadAllFunc(ctx)
+	}
+	return
+}
+
+// Send records the call, and calls SendFunc if it is set.
+func (m *MessageAPI) Send(ctx context.Context, sendRequest *reddit.SendMessageRequest) (r0 *reddit.Response, r1 error) {
+	m.record("Send", ctx, sendRequest)
+	if m.SendFunc != nil {
+		return m.SendFunc(ctx, sendRequest)
+	}
+	return
+}
+
+// Sent records the call, and calls SentFunc if it is set.
+func (m *MessageAPI) Sent(ctx context.Context, opts *reddit.ListOptions) (r0 *reddit.Messages, r1 *reddit.Response, r2 error) {
+	m.record("Sent", ctx, opts)
+	if m.SentFunc != nil {
+		return m.SentFunc(ctx, opts)
+	}
+	return
+}
+
+// Uncollapse records the call, and calls UncollapseFunc if it is set.
+func (m *MessageAPI) Uncollapse(ctx context.Context, ids ...string) (r0 *reddit.Response, r1 error) {
+	m.record("Uncollapse", ctx, ids)
+	if m.UncollapseFunc != nil {
+		return m.UncollapseFunc(ctx, ids...)
+	}
+	return
+}
+
+// Unread records the call, and calls UnreadFunc if it is set.
+func (m *MessageAPI) Unread(ctx context.Context, ids ...string) (r0 *reddit.Response, r1 error) {
+	m.record("Unread", ctx, ids)
+	if m.UnreadFunc != nil {
+		return m.UnreadFunc(ctx, ids...)
+	}
+	return
+}
+
+// ModerationAPI is a mock of reddit.ModerationAPI.
+// Its methods record their calls, and then call the function field of the same name (e.g. GetFunc for Get),
+// if set. Otherwise, they return zero values.
+type ModerationAPI struct {
+	Recorder
+
+	AcceptInviteFunc      func(ctx context.Context, subreddit string) (*reddit.Response, error)
+	ApproveFunc           func(ctx context.Context, id string) (*reddit.Response, error)
+	ApproveUserFunc       func(ctx context.Context, subreddit string, username string) (*reddit.Response, error)
+	ApproveUserWikiFunc   func(ctx context.Context, subreddit string, username string) (*reddit.Response, error)
+	BanFunc               func(ctx context.Context, subreddit string, username string, config *reddit.BanConfig) (*reddit.Response, error)
+	BanWikiFunc           func(ctx context.Context, subreddit string, username string, config *reddit.BanConfig) (*reddit.Response, error)
+	EditedFunc            func(ctx context.Context, subreddit string, opts *reddit.ListOptions) (*reddit.Posts, *reddit.Comments, *reddit.Response, error)
+	GetActionsFunc        func(ctx context.Context, subreddit string, opts *reddit.ListModActionOptions) (*reddit.ModActions, *reddit.Response, error)
+	IgnoreReportsFunc     func(ctx context.Context, id string) (*reddit.Response, error)
+	InviteFunc            func(ctx context.Context, subreddit string, username string, permissions *reddit.ModPermissions) (*reddit.Response, error)
+	LeaveFunc             func(ctx context.Context, subredditID string) (*reddit.Response, error)
+	LeaveContributorFunc  func(ctx context.Context, subredditID string) (*reddit.Response, error)
+	MuteFunc              func(ctx context.Context, subreddit string, username string) (*reddit.Response, error)
+	RemoveFunc            func(ctx context.Context, id string) (*reddit.Response, error)
+	RemoveSpamFunc        func(ctx context.Context, id string) (*reddit.Response, error)
+	SetPermissionsFunc    func(ctx context.Context, subreddit string, username string, permissions *reddit.ModPermissions) (*reddit.Response, error)
+	UnapproveUserFunc     func(ctx context.Context, subreddit string, username string) (*reddit.Response, error)
+	UnapproveUserWikiFunc func(ctx context.Context, subreddit string, username string) (*reddit.Response, error)
+	UnbanFunc             func(ctx context.Context, subreddit string, username string) (*reddit.Response, error)
+	UnbanWikiFunc         func(ctx context.Context, subreddit string, username string) (*reddit.Response, error)
+	UnignoreReportsFunc   func(ctx context.Context, id string) (*reddit.Response, error)
+	UninviteFunc          func(ctx context.Context, subreddit string, username string) (*reddit.Response, error)
+	UnmuteFunc            func(ctx context.Context, subreddit string, username string) (*reddit.Response, error)
+}
+
+var _ reddit.ModerationAPI = &ModerationAPI{}
+
+// AcceptInvite records the call, and calls AcceptInviteFunc if it is set.
+func (m *ModerationAPI) AcceptInvite(ctx context.Context, subreddit string) (r0 *reddit.Response, r1 error) {
+	m.record("AcceptInvite", ctx, subreddit)
+	if m.AcceptInviteFunc != nil {
+		return m.AcceptInviteFunc(ctx, subreddit)
+	}
+	return
+}
+
+// Approve records the call, and calls ApproveFunc if it is set.
+func (m *ModerationAPI) Approve(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("Approve", ctx, id)
+	if m.ApproveFunc != nil {
+		return m.ApproveFunc(ctx, id)
+	}
+	return
+}
+
+// ApproveUser records the call, and calls ApproveUserFunc if it is set.
+func (m *ModerationAPI) ApproveUser(ctx context.Context, subreddit string, username string) (r0 *reddit.Response, r1 error) {
+	m.record("ApproveUser", ctx, subreddit, username)
+	if m.ApproveUserFunc != nil {
+		return m.ApproveUserFunc(ctx, subreddit, username)
+	}
+	return
+}
+
+// ApproveUserWiki records the call, and calls ApproveUserWikiFunc if it is set.
+func (m *ModerationAPI) ApproveUserWiki(ctx context.Context, subreddit string, username string) (r0 *reddit.Response, r1 error) {
+	m.record("ApproveUserWiki", ctx, subreddit, username)
+	if m.ApproveUserWikiFunc != nil {
+		return m.ApproveUserWikiFunc(ctx, subreddit, username)
+	}
+	return
+}
+
+// Ban records the call, and calls BanFunc if it is set.
+func (m *ModerationAPI) Ban(ctx context.Context, subreddit string, username string, config *reddit.BanConfig) (r0 *reddit.Response, r1 error) {
+	m.record("Ban", ctx, subreddit, username, config)
+	if m.BanFunc != nil {
+		return m.BanFunc(ctx, subreddit, username, config)
+	}
+	return
+}
+
+// BanWiki records the call, and calls BanWikiFunc if it is set.
+func (m *ModerationAPI) BanWiki(ctx context.Context, subreddit string, username string, config *reddit.BanConfig) (r0 *reddit.Response, r1 error) {
+	m.record("BanWiki", ctx, subreddit, username, config)
+	if m.BanWikiFunc != nil {
+		return m.BanWikiFunc(ctx, subreddit, username, config)
+	}
+	return
+}
+
+// Edited records the call, and calls EditedFunc if it is set.
+func (m *ModerationAPI) Edited(ctx context.Context, subreddit string, opts *reddit.ListOptions) (r0 *reddit.Posts, r1 *reddit.Comments, r2 *reddit.Response, r3 error) {
+	m.record("Edited", ctx, subreddit, opts)
+	if m.EditedFunc != nil {
+		return m.EditedFunc(ctx, subreddit, opts)
+	}
+	return
+}
+
+// GetActions records the call, and calls GetActionsFunc if it is set.
+func (m *ModerationAPI) GetActions(ctx context.Context, subreddit string, opts *reddit.ListModActionOptions) (r0 *reddit.ModActions, r1 *reddit.Response, r2 error) {
+	m.record("GetActions", ctx, subreddit, opts)
+	if m.GetActionsFunc != nil {
+		return m.GetActionsFunc(ctx, subreddit, opts)
+	}
+	return
+}
+
+// IgnoreReports records the call, and calls IgnoreReportsFunc if it is set.
+func (m *ModerationAPI) IgnoreReports(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("IgnoreReports", ctx, id)
+	if m.IgnoreReportsFunc != nil {
+		return m.IgnoreReportsFunc(ctx, id)
+	}
+	return
+}
+
+// Invite records the call, and calls InviteFunc if it is set.
+func (m *ModerationAPI) Invite(ctx context.Context, subreddit string, username string, permissions *reddit.ModPermissions) (r0 *reddit.Response, r1 error) {
+	m.record("Invite", ctx, subreddit, username, permissions)
+	if m.InviteFunc != nil {
+		return m.InviteFunc(ctx, subreddit, username, permissions)
+	}
+	return
+}
+
+// Leave records the call, and calls LeaveFunc if it is set.
+func (m *ModerationAPI) Leave(ctx context.Context, subredditID string) (r0 *reddit.Response, r1 error) {
+	m.record("Leave", ctx, subredditID)
+	if m.LeaveFunc != nil {
+		return m.LeaveFunc(ctx, subredditID)
+	}
+	return
+}
+
+// LeaveContributor records the call, and calls LeaveContributorFunc if it is set.
+func (m *ModerationAPI) LeaveContributor(ctx context.Context, subredditID string) (r0 *reddit.Response, r1 error) {
+	m.record("LeaveContributor", ctx, subredditID)
+	if m.LeaveContributorFunc != nil {
+		return m.LeaveContributorFunc(ctx, subredditID)
+	}
+	return
+}
+
+// Mute records the call, and calls MuteFunc if it is set.
+func (m *ModerationAPI) Mute(ctx context.Context, subreddit string, username string) (r0 *reddit.Response, r1 error) {
+	m.record("Mute", ctx, subreddit, username)
+	if m.MuteFunc != nil {
+		return m.MuteFunc(ctx, subreddit, username)
+	}
+	return
+}
+
+// Remove records the call, and calls RemoveFunc if it is set.
+func (m *ModerationAPI) Remove(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("Remove", ctx, id)
+	if m.RemoveFunc != nil {
+		return m.RemoveFunc(ctx, id)
+	}
+	return
+}
+
+// RemoveSpam records the call, and calls RemoveSpamFunc if it is set.
+func (m *ModerationAPI) RemoveSpam(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("RemoveSpam", ctx, id)
+	if m.RemoveSpamFunc != nil {
+		return m.RemoveSpamFunc(ctx, id)
+	}
+	return
+}
+
+// SetPermissions records the call, and calls SetPermissionsFunc if it is set.
+func (m *ModerationAPI) SetPermissions(ctx context.Context, subreddit string, username string, permissions *reddit.ModPermissions) (r0 *reddit.Response, r1 error) {
+	m.record("SetPermissions", ctx, subreddit, username, permissions)
+	if m.SetPermissionsFunc != nil {
+		return m.SetPermissionsFunc(ctx, subreddit, username, permissions)
+	}
+	return
+}
+
+// UnapproveUser records the call, and calls UnapproveUserFunc if it is set.
+func (m *ModerationAPI) UnapproveUser(ctx context.Context, subreddit string, username string) (r0 *reddit.Response, r1 error) {
+	m.record("UnapproveUser", ctx, subreddit, username)
+	if m.UnapproveUserFunc != nil {
+		return m.UnapproveUserFunc(ctx, subreddit, username)
+	}
+	return
+}
+
+// UnapproveUserWiki records the call, and calls UnapproveUserWikiFunc if it is set.
+func (m *ModerationAPI) UnapproveUserWiki(ctx context.Context, subreddit string, username string) (r0 *reddit.Response, r1 error) {
+	m.record("UnapproveUserWiki", ctx, subreddit, username)
+	if m.UnapproveUserWikiFunc != nil {
+		return m.UnapproveUserWikiFunc(ctx, subreddit, username)
+	}
+	return
+}
+
+// Unban records the call, and calls UnbanFunc if it is set.
+func (m *ModerationAPI) Unban(ctx context.Context, subreddit string, username string) (r0 *reddit.Response, r1 error) {
+	m.record("Unban", ctx, subreddit, username)
+	if m.UnbanFunc != nil {
+		return m.UnbanFunc(ctx, subreddit, username)
+	}
+	return
+}
+
+// UnbanWiki records the call, and calls UnbanWikiFunc if it is set.
+func (m *ModerationAPI) UnbanWiki(ctx context.Context, subreddit string, username string) (r0 *reddit.Response, r1 error) {
+	m.record("UnbanWiki", ctx, subreddit, username)
+	if m.UnbanWikiFunc != nil {
+		return m.UnbanWikiFunc(ctx, subreddit, username)
+	}
+	return
+}
+
+// UnignoreReports records the call, and calls UnignoreReportsFunc if it is set.
+func (m *ModerationAPI) UnignoreReports(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("UnignoreReports", ctx, id)
+	if m.UnignoreReportsFunc != nil {
+		return m.UnignoreReportsFunc(ctx, id)
+	}
+	return
+}
+
+// Uninvite records the call, and calls UninviteFunc if it is set.
+func (m *ModerationAPI) Uninvite(ctx context.Context, subreddit string, username string) (r0 *reddit.Response, r1 error) {
+	m.record("Uninvite", ctx, subreddit, username)
+	if m.UninviteFunc != nil {
+		return m.UninviteFunc(ctx, subreddit, username)
+	}
+	return
+}
+
+// Unmute records the call, and calls UnmuteFunc if it is set.
+func (m *ModerationAPI) Unmute(ctx context.Context, subreddit string, username string) (r0 *reddit.Response, r1 error) {
+	m.record("Unmute", ctx, subreddit, username)
+	if m.UnmuteFunc != nil {
+		return m.UnmuteFunc(ctx, subreddit, username)
+	}
+	return
+}
+
+// MultiAPI is a mock of reddit.MultiAPI.
+// Its methods record their calls, and then call the function field of the same name (e.g. GetFunc for Get),
+// if set. Otherwise, they return zero values.
+type MultiAPI struct {
+	Recorder
+
+	AddSubredditFunc      func(ctx context.Context, multiPath string, subreddit string) (*reddit.Response, error)
+	CopyFunc              func(ctx context.Context, copyRequest *reddit.MultiCopyRequest) (*reddit.Multi, *reddit.Response, error)
+	CreateFunc            func(ctx context.Context, createRequest *reddit.MultiCreateOrUpdateRequest) (*reddit.Multi, *reddit.Response, error)
+	DeleteFunc            func(ctx context.Context, multiPath string) (*reddit.Response, error)
+	DeleteSubredditFunc   func(ctx context.Context, multiPath string, subreddit string) (*reddit.Response, error)
+	GetFunc               func(ctx context.Context, multiPath string) (*reddit.Multi, *reddit.Response, error)
+	GetDescriptionFunc    func(ctx context.Context, multiPath string) (string, *reddit.Response, error)
+	MineFunc              func(ctx context.Context) ([]reddit.Multi, *reddit.Response, error)
+	OfFunc                func(ctx context.Context, username string) ([]reddit.Multi, *reddit.Response, error)
+	UpdateFunc            func(ctx context.Context, multiPath string, updateRequest *reddit.MultiCreateOrUpdateRequest) (*reddit.Multi, *reddit.Response, error)
+	UpdateDescriptionFunc func(ctx context.Context, multiPath string, description string) (string, *reddit.Response, error)
+}
+
+var _ reddit.MultiAPI = &MultiAPI{}
+
+// AddSubreddit records the call, and calls AddSubredditFunc if it is set.
+func (m *MultiAPI) AddSubreddit(ctx context.Context, multiPath string, subreddit string) (r0 *reddit.Response, r1 error) {
+	m.record("AddSubreddit", ctx, multiPath, subreddit)
+	if m.AddSubredditFunc != nil {
+		return m.AddSubredditFunc(ctx, multiPath, subreddit)
+	}
+	return
+}
+
+// Copy records the call, and calls CopyFunc if it is set.
+func (m *MultiAPI) Copy(ctx context.Context, copyRequest *reddit.MultiCopyRequest) (r0 *reddit.Multi, r1 *reddit.Response, r2 error) {
+	m.record("Copy", ctx, copyRequest)
+	if m.CopyFunc != nil {
+		return m.CopyFunc(ctx, copyRequest)
+	}
+	return
+}
+
+// Create records the call, and calls CreateFunc if it is set.
+func (m *MultiAPI) Create(ctx context.Context, createRequest *reddit.MultiCreateOrUpdateRequest) (r0 *reddit.Multi, r1 *reddit.Response, r2 error) {
+	m.record("Create", ctx, createRequest)
+	if m.CreateFunc != nil {
+		return m.CreateFunc(ctx, createRequest)
+	}
+	return
+}
+
+// Delete records the call, and calls DeleteFunc if it is set.
+func (m *MultiAPI) Delete(ctx context.Context, multiPath string) (r0 *reddit.Response, r1 error) {
+	m.record("Delete", ctx, multiPath)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, multiPath)
+	}
+	return
+}
+
+// DeleteSubreddit records the call, and calls DeleteSubredditFunc if it is set.
+func (m *MultiAPI) DeleteSubreddit(ctx context.Context, multiPath string, subreddit string) (r0 *reddit.Response, r1 error) {
+	m.record("DeleteSubreddit", ctx, multiPath, subreddit)
+	if m.DeleteSubredditFunc != nil {
+		return m.DeleteSubredditFunc(ctx, multiPath, subreddit)
+	}
+	return
+}
+
+// Get records the call, and calls GetFunc if it is set.
+func (m *MultiAPI) Get(ctx context.Context, multiPath string) (r0 *reddit.Multi, r1 *reddit.Response, r2 error) {
+	m.record("Get", ctx, multiPath)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, multiPath)
+	}
+	return
+}
+
+// GetDescription records the call, and calls GetDescriptionFunc if it is set.
+func (m *MultiAPI) GetDescription(ctx context.Context, multiPath string) (r0 string, r1 *reddit.Response, r2 error) {
+	m.record("GetDescription", ctx, multiPath)
+	if m.GetDescriptionFunc != nil {
+		return m.GetDescriptionFunc(ctx, multiPath)
+	}
+	return
+}
+
+// Mine records the call, and calls MineFunc if it is set.
+func (m *MultiAPI) Mine(ctx context.Context) (r0 []reddit.Multi, r1 *reddit.Response, r2 error) {
+	m.record("Mine", ctx)
+	if m.MineFunc != nil {
+		return m.MineFunc(ctx)
+	}
+	return
+}
+
+// Of records the call, and calls OfFunc if it is set.
+func (m *MultiAPI) Of(ctx context.Context, username string) (r0 []reddit.Multi, r1 *reddit.Response, r2 error) {
+	m.record("Of", ctx, username)
+	if m.OfFunc != nil {
+		return m.OfFunc(ctx, username)
+	}
+	return
+}
+
+// Update records the call, and calls UpdateFunc if it is set.
+func (m *MultiAPI) Update(ctx context.Context, multiPath string, updateRequest *reddit.MultiCreateOrUpdateRequest) (r0 *reddit.Multi, r1 *reddit.Response, r2 error) {
+	m.record("Update", ctx, multiPath, updateRequest)
+	if m.UpdateFunc != nil {
+		return m.UpdateFunc(ctx, multiPath, updateRequest)
+	}
+	return
+}
+
+// UpdateDescription records the call, and calls UpdateDescriptionFunc if it is set.
+func (m *MultiAPI) UpdateDescription(ctx context.Context, multiPath string, description string) (r0 string, r1 *reddit.Response, r2 error) {
+	m.record("UpdateDescription", ctx, multiPath, description)
+	if m.UpdateDescriptionFunc != nil {
+		return m.UpdateDescriptionFunc(ctx, multiPath, description)
+	}
+	return
+}
+
+// PostAPI is a mock of reddit.PostAPI.
+// Its methods record their calls, and then call the function field of the same name (e.g. GetFunc for Get),
+// if set. Otherwise, they return zero values.
+type PostAPI struct {
+	Recorder
+
+	ClearSuggestedSortFunc            func(ctx context.Context, id string) (*reddit.Response, error)
+	DeleteFunc                        func(ctx context.Context, id string) (*reddit.Response, error)
+	DisableContestModeFunc            func(ctx context.Context, id string) (*reddit.Response, error)
+	DisableRepliesFunc                func(ctx context.Context, id string) (*reddit.Response, error)
+	DownvoteFunc                      func(ctx context.Context, id string) (*reddit.Response, error)
+	DuplicatesFunc                    func(ctx context.Context, id string, opts *reddit.ListDuplicatePostOptions) (*reddit.Post, *reddit.Posts, *reddit.Response, error)
+	EditFunc                          func(ctx context.Context, id string, text string) (*reddit.Post, *reddit.Response, error)
+	EnableContestModeFunc             func(ctx context.Context, id string) (*reddit.Response, error)
+	EnableRepliesFunc                 func(ctx context.Context, id string) (*reddit.Response, error)
+	GetFunc                           func(ctx context.Context, id string) (*reddit.PostAndComments, *reddit.Response, error)
+	HideFunc                          func(ctx context.Context, ids ...string) (*reddit.Response, error)
+	LoadMoreCommentsFunc              func(ctx context.Context, pc *reddit.PostAndComments) (*reddit.Response, error)
+	LockFunc                          func(ctx context.Context, id string) (*reddit.Response, error)
+	MarkNSFWFunc                      func(ctx context.Context, id string) (*reddit.Response, error)
+	MarkVisitedFunc                   func(ctx context.Context, ids ...string) (*reddit.Response, error)
+	PinToProfileFunc                  func(ctx context.Context, id string) (*reddit.Response, error)
+	RandomFunc                        func(ctx context.Context) (*reddit.PostAndComments, *reddit.Response, error)
+	RandomFromSubredditsFunc          func(ctx context.Context, subreddits ...string) (*reddit.PostAndComments, *reddit.Response, error)
+	RandomFromSubscriptionsFunc       func(ctx context.Context) (*reddit.PostAndComments, *reddit.Response, error)
+	RemoveVoteFunc                    func(ctx context.Context, id string) (*reddit.Response, error)
+	ReportFunc                        func(ctx context.Context, id string, reason string) (*reddit.Response, error)
+	SaveFunc                          func(ctx context.Context, id string) (*reddit.Response, error)
+	SetSuggestedSortAMAFunc           func(ctx context.Context, id string) (*reddit.Response, error)
+	SetSuggestedSortBestFunc          func(ctx context.Context, id string) (*reddit.Response, error)
+	SetSuggestedSortControversialFunc func(ctx context.Context, id string) (*reddit.Response, error)
+	SetSuggestedSortLiveFunc          func(ctx context.Context, id string) (*reddit.Response, error)
+	SetSuggestedSortNewFunc           func(ctx context.Context, id string) (*reddit.Response, error)
+	SetSuggestedSortOldFunc           func(ctx context.Context, id string) (*reddit.Response, error)
+	SetSuggestedSortRandomFunc        func(ctx context.Context, id string) (*reddit.Response, error)
+	SetSuggestedSortTopFunc           func(ctx context.Context, id string) (*reddit.Response, error)
+	SpoilerFunc                       func(ctx context.Context, id string) (*reddit.Response, error)
+	StickyFunc                        func(ctx context.Context, id string, bottom bool) (*reddit.Response, error)
+	SubmitLinkFunc                    func(ctx context.Context, opts reddit.SubmitLinkOptions) (*reddit.Submitted, *reddit.Response, error)
+	SubmitTextFunc                    func(ctx context.Context, opts reddit.SubmitTextOptions) (*reddit.Submitted, *reddit.Response, error)
+	UnhideFunc                        func(ctx context.Context, ids ...string) (*reddit.Response, error)
+	UnlockFunc                        func(ctx context.Context, id string) (*reddit.Response, error)
+	UnmarkNSFWFunc                    func(ctx context.Context, id string) (*reddit.Response, error)
+	UnpinFromProfileFunc              func(ctx context.Context, id string) (*reddit.Response, error)
+	UnsaveFunc                        func(ctx context.Context, id string) (*reddit.Response, error)
+	UnspoilerFunc                     func(ctx context.Context, id string) (*reddit.Response, error)
+	UnstickyFunc                      func(ctx context.Context, id string) (*reddit.Response, error)
+	UpvoteFunc                        func(ctx context.Context, id string) (*reddit.Response, error)
+}
+
+var _ reddit.PostAPI = &PostAPI{}
+
+// ClearSuggestedSort records the call, and calls ClearSuggestedSortFunc if it is set.
+func (m *PostAPI) ClearSuggestedSort(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("ClearSuggestedSort", ctx, id)
+	if m.ClearSuggestedSortFunc != nil {
+		return m.ClearSuggestedSortFunc(ctx, id)
+	}
+	return
+}
+
+// Delete records the call, and calls DeleteFunc if it is set.
+func (m *PostAPI) Delete(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("Delete", ctx, id)
+	if m.DeleteFunc != nil {
+		return m.DeleteFunc(ctx, id)
+	}
+	return
+}
+
+// DisableContestMode records the call, and calls DisableContestModeFunc if it is set.
+func (m *PostAPI) DisableContestMode(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("DisableContestMode", ctx, id)
+	if m.DisableContestModeFunc != nil {
+		return m.DisableContestModeFunc(ctx, id)
+	}
+	return
+}
+
+// DisableReplies records the call, and calls DisableRepliesFunc if it is set.
+func (m *PostAPI) DisableReplies(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("DisableReplies", ctx, id)
+	if m.DisableRepliesFunc != nil {
+		return m.DisableRepliesFunc(ctx, id)
+	}
+	return
+}
+
+// Downvote records the call, and calls DownvoteFunc if it is set.
+func (m *PostAPI) Downvote(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("Downvote", ctx, id)
+	if m.DownvoteFunc != nil {
+		return m.DownvoteFunc(ctx, id)
+	}
+	return
+}
+
+// Duplicates records the call, and calls DuplicatesFunc if it is set.
+func (m *PostAPI) Duplicates(ctx context.Context, id string, opts *reddit.ListDuplicatePostOptions) (r0 *reddit.Post, r1 *reddit.Posts, r2 *reddit.Response, r3 error) {
+	m.record("Duplicates", ctx, id, opts)
+	if m.DuplicatesFunc != nil {
+		return m.DuplicatesFunc(ctx, id, opts)
+	}
+	return
+}
+
+// Edit records the call, and calls EditFunc if it is set.
+func (m *PostAPI) Edit(ctx context.Context, id string, text string) (r0 *reddit.Post, r1 *reddit.Response, r2 error) {
+	m.record("Edit", ctx, id, text)
+	if m.EditFunc != nil {
+		return m.EditFunc(ctx, id, text)
+	}
+	return
+}
+
+// EnableContestMode records the call, and calls EnableContestModeFunc if it is set.
+func (m *PostAPI) EnableContestMode(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("EnableContestMode", ctx, id)
+	if m.EnableContestModeFunc != nil {
+		return m.EnableContestModeFunc(ctx, id)
+	}
+	return
+}
+
+// EnableReplies records the call, and calls EnableRepliesFunc if it is set.
+func (m *PostAPI) EnableReplies(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("EnableReplies", ctx, id)
+	if m.EnableRepliesFunc != nil {
+		return m.EnableRepliesFunc(ctx, id)
+	}
+	return
+}
+
+// Get records the call, and calls GetFunc if it is set.
+func (m *PostAPI) Get(ctx context.Context, id string) (r0 *reddit.PostAndComments, r1 *reddit.Response, r2 error) {
+	m.record("Get", ctx, id)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, id)
+	}
+	return
+}
+
+// Hide records the call, and calls HideFunc if it is set.
+func (m *PostAPI) Hide(ctx context.Context, ids ...string) (r0 *reddit.Response, r1 error) {
+	m.record("Hide", ctx, ids)
+	if m.HideFunc != nil {
+		return m.HideFunc(ctx, ids...)
+	}
+	return
+}
+
+// LoadMoreComments records the call, and calls LoadMoreCommentsFunc if it is set.
+func (m *PostAPI) LoadMoreComments(ctx context.Context, pc *reddit.PostAndComments) (r0 *reddit.Response, r1 error) {
+	m.record("LoadMoreComments", ctx, pc)
+	if m.LoadMoreCommentsFunc != nil {
+		return m.LoadMoreCommentsFunc(ctx, pc)
+	}
+	return
+}
+
+// Lock records the call, and calls LockFunc if it is set.
+func (m *PostAPI) Lock(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("Lock", ctx, id)
+	if m.LockFunc != nil {
+		return m.LockFunc(ctx, id)
+	}
+	return
+}
+
+// MarkNSFW records the call, and calls MarkNSFWFunc if it is set.
+func (m *PostAPI) MarkNSFW(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("MarkNSFW", ctx, id)
+	if m.MarkNSFWFunc != nil {
+		return m.MarkNSFWFunc(ctx, id)
+	}
+	return
+}
+
+// MarkVisited records the call, and calls MarkVisitedFunc if it is set.
+func (m *PostAPI) MarkVisited(ctx context.Context, ids ...string) (r0 *reddit.Response, r1 error) {
+	m.record("MarkVisited", ctx, ids)
+	if m.MarkVisitedFunc != nil {
+		return m.MarkVisitedFunc(ctx, ids...)
+	}
+	return
+}
+
+// PinToProfile records the call, and calls PinToProfileFunc if it is set.
+func (m *PostAPI) PinToProfile(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("PinToProfile", ctx, id)
+	if m.PinToProfileFunc != nil {
+		return m.PinToProfileFunc(ctx, id)
+	}
+	return
+}
+
+// Random records the call, and calls RandomFunc if it is set.
+func (m *PostAPI) Random(ctx context.Context) (r0 *reddit.PostAndComments, r1 *reddit.Response, r2 error) {
+	m.record("Random", ctx)
+	if m.RandomFunc != nil {
+		return m.RandomFunc(ctx)
+	}
+	return
+}
+
+// RandomFromSubreddits records the call, and calls RandomFromSubredditsFunc if it is set.
+func (m *PostAPI) RandomFromSubreddits(ctx context.Context, subreddits ...string) (r0 *reddit.PostAndComments, r1 *reddit.Response, r2 error) {
+	m.record("RandomFromSubreddits", ctx, subreddits)
+	if m.RandomFromSubredditsFunc != nil {
+		return m.RandomFromSubredditsFunc(ctx, subreddits...)
+	}
+	return
+}
+
+// RandomFromSubscriptions records the call, and calls RandomFromSubscriptionsFunc if it is set.
+func (m *PostAPI) RandomFromSubscriptions(ctx context.Context) (r0 *reddit.PostAndComments, r1 *reddit.Response, r2 error) {
+	m.record("RandomFromSubscriptions", ctx)
+	if m.RandomFromSubscriptionsFunc != nil {
+		return m.RandomFromSubscriptionsFunc(ctx)
+	}
+	return
+}
+
+// RemoveVote records the call, and calls RemoveVoteFunc if it is set.
+func (m *PostAPI) RemoveVote(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("RemoveVote", ctx, id)
+	if m.RemoveVoteFunc != nil {
+		return m.RemoveVoteFunc(ctx, id)
+	}
+	return
+}
+
+// Report records the call, and calls ReportFunc if it is set.
+func (m *PostAPI) Report(ctx context.Context, id string, reason string) (r0 *reddit.Response, r1 error) {
+	m.record("Report", ctx, id, reason)
+	if m.ReportFunc != nil {
+		return m.ReportFunc(ctx, id, reason)
+	}
+	return
+}
+
+// Save records the call, and calls SaveFunc if it is set.
+func (m *PostAPI) Save(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("Save", ctx, id)
+	if m.SaveFunc != nil {
+		return m.SaveFunc(ctx, id)
+	}
+	return
+}
+
+// SetSuggestedSortAMA records the call, and calls SetSuggestedSortAMAFunc if it is set.
+func (m *PostAPI) SetSuggestedSortAMA(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("SetSuggestedSortAMA", ctx, id)
+	if m.SetSuggestedSortAMAFunc != nil {
+		return m.SetSuggestedSortAMAFunc(ctx, id)
+	}
+	return
+}
+
+// SetSuggestedSortBest records the call, and calls SetSuggestedSortBestFunc if it is set.
+func (m *PostAPI) SetSuggestedSortBest(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("SetSuggestedSortBest", ctx, id)
+	if m.SetSuggestedSortBestFunc != nil {
+		return m.SetSuggestedSortBestFunc(ctx, id)
+	}
+	return
+}
+
+// SetSuggestedSortControversial records the call, and calls SetSuggestedSortControversialFunc if it is set.
+func (m *PostAPI) SetSuggestedSortControversial(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("SetSuggestedSortControversial", ctx, id)
+	if m.SetSuggestedSortControversialFunc != nil {
+		return m.SetSuggestedSortControversialFunc(ctx, id)
+	}
+	return
+}
+
+// SetSuggestedSortLive records the call, and calls SetSuggestedSortLiveFunc if it is set.
+func (m *PostAPI) SetSuggestedSortLive(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("SetSuggestedSortLive", ctx, id)
+	if m.SetSuggestedSortLiveFunc != nil {
+		return m.SetSuggestedSortLiveFunc(ctx, id)
+	}
+	return
+}
+
+// SetSuggestedSortNew records the call, and calls SetSuggestedSortNewFunc if it is set.
+func (m *PostAPI) SetSuggestedSortNew(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("SetSuggestedSortNew", ctx, id)
+	if m.SetSuggestedSortNewFunc != nil {
+		return m.SetSuggestedSortNewFunc(ctx, id)
+	}
+	return
+}
+
+// SetSuggestedSortOld records the call, and calls SetSuggestedSortOldFunc if it is set.
+func (m *PostAPI) SetSuggestedSortOld(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("SetSuggestedSortOld", ctx, id)
+	if m.SetSuggestedSortOldFunc != nil {
+		return m.SetSuggestedSortOldFunc(ctx, id)
+	}
+	return
+}
+
+// SetSuggestedSortRandom records the call, and calls SetSuggestedSortRandomFunc if it is set.
+func (m *PostAPI) SetSuggestedSortRandom(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("SetSuggestedSortRandom", ctx, id)
+	if m.SetSuggestedSortRandomFunc != nil {
+		return m.SetSuggestedSortRandomFunc(ctx, id)
+	}
+	return
+}
+
+// SetSuggestedSortTop records the call, and calls SetSuggestedSortTopFunc if it is set.
+func (m *PostAPI) SetSuggestedSortTop(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("SetSuggestedSortTop", ctx, id)
+	if m.SetSuggestedSortTopFunc != nil {
+		return m.SetSuggestedSortTopFunc(ctx, id)
+	}
+	return
+}
+
+// Spoiler records the call, and calls SpoilerFunc if it is set.
+func (m *PostAPI) Spoiler(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("Spoiler", ctx, id)
+	if m.SpoilerFunc != nil {
+		return m.SpoilerFunc(ctx, id)
+	}
+	return
+}
+
+// Sticky records the call, and calls StickyFunc if it is set.
+func (m *PostAPI) Sticky(ctx context.Context, id string, bottom bool) (r0 *reddit.Response, r1 error) {
+	m.record("Sticky", ctx, id, bottom)
+	if m.StickyFunc != nil {
+		return m.StickyFunc(ctx, id, bottom)
+	}
+	return
+}
+
+// SubmitLink records the call, and calls SubmitLinkFunc if it is set.
+func (m *PostAPI) SubmitLink(ctx context.Context, opts reddit.SubmitLinkOptions) (r0 *reddit.Submitted, r1 *reddit.Response, r2 error) {
+	m.record("SubmitLink", ctx, opts)
+	if m.SubmitLinkFunc != nil {
+		return m.SubmitLinkFunc(ctx, opts)
+	}
+	return
+}
+
+// SubmitText records the call, and calls SubmitTextFunc if it is set.
+func (m *PostAPI) SubmitText(ctx context.Context, opts reddit.SubmitTextOptions) (r0 *reddit.Submitted, r1 *reddit.Response, r2 error) {
+	m.record("SubmitText", ctx, opts)
+	if m.SubmitTextFunc != nil {
+		return m.SubmitTextFunc(ctx, opts)
+	}
+	return
+}
+
+// Unhide records the call, and calls UnhideFunc if it is set.
+func (m *PostAPI) Unhide(ctx context.Context, ids ...string) (r0 *reddit.Response, r1 error) {
+	m.record("Unhide", ctx, ids)
+	if m.UnhideFunc != nil {
+		return m.UnhideFunc(ctx, ids...)
+	}
+	return
+}
+
+// Unlock records the call, and calls UnlockFunc if it is set.
+func (m *PostAPI) Unlock(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("Unlock", ctx, id)
+	if m.UnlockFunc != nil {
+		return m.UnlockFunc(ctx, id)
+	}
+	return
+}
+
+// UnmarkNSFW records the call, and calls UnmarkNSFWFunc if it is set.
+func (m *PostAPI) UnmarkNSFW(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("UnmarkNSFW", ctx, id)
+	if m.UnmarkNSFWFunc != nil {
+		return m.UnmarkNSFWFunc(ctx, id)
+	}
+	return
+}
+
+// UnpinFromProfile records the call, and calls UnpinFromProfileFunc if it is set.
+func (m *PostAPI) UnpinFromProfile(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("UnpinFromProfile", ctx, id)
+	if m.UnpinFromProfileFunc != nil {
+		return m.UnpinFromProfileFunc(ctx, id)
+	}
+	return
+}
+
+// Unsave records the call, and calls UnsaveFunc if it is set.
+func (m *PostAPI) Unsave(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("Unsave", ctx, id)
+	if m.UnsaveFunc != nil {
+		return m.UnsaveFunc(ctx, id)
+	}
+	return
+}
+
+// Unspoiler records the call, and calls UnspoilerFunc if it is set.
+func (m *PostAPI) Unspoiler(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("Unspoiler", ctx, id)
+	if m.UnspoilerFunc != nil {
+		return m.UnspoilerFunc(ctx, id)
+	}
+	return
+}
+
+// Unsticky records the call, and calls UnstickyFunc if it is set.
+func (m *PostAPI) Unsticky(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("Unsticky", ctx, id)
+	if m.UnstickyFunc != nil {
+		return m.UnstickyFunc(ctx, id)
+	}
+	return
+}
+
+// Upvote records the call, and calls UpvoteFunc if it is set.
+func (m *PostAPI) Upvote(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("Upvote", ctx, id)
+	if m.UpvoteFunc != nil {
+		return m.UpvoteFunc(ctx, id)
+	}
+	return
+}
+
+// StreamAPI is a mock of reddit.StreamAPI.
+// Its methods record their calls, and then call the function field of the same name (e.g. GetFunc for Get),
+// if set. Otherwise, they return zero values.
+type StreamAPI struct {
+	Recorder
+
+	CommentRepliesFunc func(ctx context.Context, opts ...reddit.StreamOpt) (<-chan *reddit.Message, <-chan error, func())
+	CommentsFunc       func(ctx context.Context, subreddit string, opts ...reddit.StreamOpt) (<-chan *reddit.Comment, <-chan error, func())
+	EditedFunc         func(ctx context.Context, subreddit string, opts ...reddit.StreamOpt) (<-chan *reddit.ModerationItem, <-chan error, func())
+	InboxFunc          func(ctx context.Context, opts ...reddit.StreamOpt) (<-chan *reddit.Message, <-chan error, func())
+	InboxUnreadFunc    func(ctx context.Context, opts ...reddit.StreamOpt) (<-chan *reddit.Message, <-chan error, func())
+	MentionsFunc       func(ctx context.Context, opts ...reddit.StreamOpt) (<-chan *reddit.Message, <-chan error, func())
+	MessagesFunc       func(ctx context.Context, opts ...reddit.StreamOpt) (<-chan *reddit.Message, <-chan error, func())
+	ModActionsFunc     func(ctx context.Context, subreddit string, opts ...reddit.StreamOpt) (<-chan *reddit.ModAction, <-chan error, func())
+	ModQueueFunc       func(ctx context.Context, subreddit string, opts ...reddit.StreamOpt) (<-chan *reddit.ModerationItem, <-chan error, func())
+	PostRepliesFunc    func(ctx context.Context, opts ...reddit.StreamOpt) (<-chan *reddit.Message, <-chan error, func())
+	PostsFunc          func(ctx context.Context, subreddit string, opts ...reddit.StreamOpt) (<-chan *reddit.Post, <-chan error, func())
+	ReportsFunc        func(ctx context.Context, subreddit string, opts ...reddit.StreamOpt) (<-chan *reddit.ModerationItem, <-chan error, func())
+	SpamFunc           func(ctx context.Context, subreddit string, opts ...reddit.StreamOpt) (<-chan *reddit.ModerationItem, <-chan error, func())
+	UnmoderatedFunc    func(ctx context.Context, subreddit string, opts ...reddit.StreamOpt) (<-chan *reddit.ModerationItem, <-chan error, func())
+}
+
+var _ reddit.StreamAPI = &StreamAPI{}
+
+// CommentReplies records the call, and calls CommentRepliesFunc if it is set.
+func (m *StreamAPI) CommentReplies(ctx context.Context, opts ...reddit.StreamOpt) (r0 <-chan *reddit.Message, r1 <-chan error, r2 func()) {
+	m.record("CommentReplies", ctx, opts)
+	if m.CommentRepliesFunc != nil {
+		return m.CommentRepliesFunc(ctx, opts...)
+	}
+	return
+}
+
+// Comments records the call, and calls CommentsFunc if it is set.
+func (m *StreamAPI) Comments(ctx context.Context, subreddit string, opts ...reddit.StreamOpt) (r0 <-chan *reddit.Comment, r1 <-chan error, r2 func()) {
+	m.record("Comments", ctx, subreddit, opts)
+	if m.CommentsFunc != nil {
+		return m.CommentsFunc(ctx, subreddit, opts...)
+	}
+	return
+}
+
+// Edited records the call, and calls EditedFunc if it is set.
+func (m *StreamAPI) Edited(ctx context.Context, subreddit string, opts ...reddit.StreamOpt) (r0 <-chan *reddit.ModerationItem, r1 <-chan error, r2 func()) {
+	m.record("Edited", ctx, subreddit, opts)
+	if m.EditedFunc != nil {
+		return m.EditedFunc(ctx, subreddit, opts...)
+	}
+	return
+}
+
+// Inbox records the call, and calls InboxFunc if it is set.
+func (m *StreamAPI) Inbox(ctx context.Context, opts ...reddit.StreamOpt) (r0 <-chan *reddit.Message, r1 <-chan error, r2 func()) {
+	m.record("Inbox", ctx, opts)
+	if m.InboxFunc != nil {
+		return m.InboxFunc(ctx, opts...)
+	}
+	return
+}
+
+// InboxUnread records the call, and calls InboxUnreadFunc if it is set.
+func (m *StreamAPI) InboxUnread(ctx context.Context, opts ...reddit.StreamOpt) (r0 <-chan *reddit.Message, r1 <-chan error, r2 func()) {
+	m.record("InboxUnread", ctx, opts)
+	if m.InboxUnreadFunc != nil {
+		return m.InboxUnreadFunc(ctx, opts...)
+	}
+	return
+}
+
+// Mentions records the call, and calls MentionsFunc if it is set.
+func (m *StreamAPI) Mentions(ctx context.Context, opts ...reddit.StreamOpt) (r0 <-chan *reddit.Message, r1 <-chan error, r2 func()) {
+	m.record("Mentions", ctx, opts)
+	if m.MentionsFunc != nil {
+		return m.MentionsFunc(ctx, opts...)
+	}
+	return
+}
+
+// Messages records the call, and calls MessagesFunc if it is set.
+func (m *StreamAPI) Messages(ctx context.Context, opts ...reddit.StreamOpt) (r0 <-chan *reddit.Message, r1 <-chan error, r2 func()) {
+	m.record("Messages", ctx, opts)
+	if m.MessagesFunc != nil {
+		return m.MessagesFunc(ctx, opts...)
+	}
+	return
+}
+
+// ModActions records the call, and calls ModActionsFunc if it is set.
+func (m *StreamAPI) ModActions(ctx context.Context, subreddit string, opts ...reddit.StreamOpt) (r0 <-chan *reddit.ModAction, r1 <-chan error, r2 func()) {
+	m.record("ModActions", ctx, subreddit, opts)
+	if m.ModActionsFunc != nil {
+		return m.ModActionsFunc(ctx, subreddit, opts...)
+	}
+	return
+}
+
+// ModQueue records the call, and calls ModQueueFunc if it is set.
+func (m *StreamAPI) ModQueue(ctx context.Context, subreddit string, opts ...reddit.StreamOpt) (r0 <-chan *reddit.ModerationItem, r1 <-chan error, r2 func()) {
+	m.record("ModQueue", ctx, subreddit, opts)
+	if m.ModQueueFunc != nil {
+		return m.ModQueueFunc(ctx, subreddit, opts...)
+	}
+	return
+}
+
+// PostReplies records the call, and calls PostRepliesFunc if it is set.
+func (m *StreamAPI) PostReplies(ctx context.Context, opts ...reddit.StreamOpt) (r0 <-chan *reddit.Message, r1 <-chan error, r2 func()) {
+	m.record("PostReplies", ctx, opts)
+	if m.PostRepliesFunc != nil {
+		return m.PostRepliesFunc(ctx, opts...)
+	}
+	return
+}
+
+// Posts records the call, and calls PostsFunc if it is set.
+func (m *StreamAPI) Posts(ctx context.Context, subreddit string, opts ...reddit.StreamOpt) (r0 <-chan *reddit.Post, r1 <-chan error, r2 func()) {
+	m.record("Posts", ctx, subreddit, opts)
+	if m.PostsFunc != nil {
+		return m.PostsFunc(ctx, subreddit, opts...)
+	}
+	return
+}
+
+// Reports records the call, and calls ReportsFunc if it is set.
+func (m *StreamAPI) Reports(ctx context.Context, subreddit string, opts ...reddit.StreamOpt) (r0 <-chan *reddit.ModerationItem, r1 <-chan error, r2 func()) {
+	m.record("Reports", ctx, subreddit, opts)
+	if m.ReportsFunc != nil {
+		return m.ReportsFunc(ctx, subreddit, opts...)
+	}
+	return
+}
+
+// Spam records the call, and calls SpamFunc if it is set.
+func (m *StreamAPI) Spam(ctx context.Context, subreddit string, opts ...reddit.StreamOpt) (r0 <-chan *reddit.ModerationItem, r1 <-chan error, r2 func()) {
+	m.record("Spam", ctx, subreddit, opts)
+	if m.SpamFunc != nil {
+		return m.SpamFunc(ctx, subreddit, opts...)
+	}
+	return
+}
+
+// Unmoderated records the call, and calls UnmoderatedFunc if it is set.
+func (m *StreamAPI) Unmoderated(ctx context.Context, subreddit string, opts ...reddit.StreamOpt) (r0 <-chan *reddit.ModerationItem, r1 <-chan error, r2 func()) {
+	m.record("Unmoderated", ctx, subreddit, opts)
+	if m.UnmoderatedFunc != nil {
+		return m.UnmoderatedFunc(ctx, subreddit, opts...)
+	}
+	return
+}
+
+// SubredditAPI is a mock of reddit.SubredditAPI.
+// Its methods record their calls, and then call the function field of the same name (e.g. GetFunc for Get),
+// if set. Otherwise, they return zero values.
+type SubredditAPI struct {
+	Recorder
+
+	ApprovedFunc           func(ctx context.Context, opts *reddit.ListSubredditOptions) (*reddit.Subreddits, *reddit.Response, error)
+	BannedFunc             func(ctx context.Context, subreddit string, opts *reddit.ListOptions) (*reddit.Bans, *reddit.Response, error)
+	ContributorsFunc       func(ctx context.Context, subreddit string, opts *reddit.ListOptions) (*reddit.Relationships, *reddit.Response, error)
+	ControversialPostsFunc func(ctx context.Context, subreddit string, opts *reddit.ListPostOptions) (*reddit.Posts, *reddit.Response, error)
+	DefaultFunc            func(ctx context.Context, opts *reddit.ListSubredditOptions) (*reddit.Subreddits, *reddit.Response, error)
+	FavoriteFunc           func(ctx context.Context, subreddit string) (*reddit.Response, error)
+	GetFunc                func(ctx context.Context, name string) (*reddit.Subreddit, *reddit.Response, error)
+	GetSticky1Func         func(ctx context.Context, subreddit string) (*reddit.PostAndComments, *reddit.Response, error)
+	GetSticky2Func         func(ctx context.Context, subreddit string) (*reddit.PostAndComments, *reddit.Response, error)
+	GoldFunc               func(ctx context.Context, opts *reddit.ListSubredditOptions) (*reddit.Subreddits, *reddit.Response, error)
+	HotPostsFunc           func(ctx context.Context, subreddit string, opts *reddit.ListOptions) (*reddit.Posts, *reddit.Response, error)
+	ModeratedFunc          func(ctx context.Context, opts *reddit.ListSubredditOptions) (*reddit.Subreddits, *reddit.Response, error)
+	ModeratorsFunc         func(ctx context.Context, subreddit string) ([]*reddit.Moderator, *reddit.Response, error)
+	MutedFunc              func(ctx context.Context, subreddit string, opts *reddit.ListOptions) (*reddit.Relationships, *reddit.Response, error)
+	NewFunc                func(ctx context.Context, opts *reddit.ListSubredditOptions) (*reddit.Subreddits, *reddit.Response, error)
+	NewPostsFunc           func(ctx context.Context, subreddit string, opts *reddit.ListOptions) (*reddit.Posts, *reddit.Response, error)
+	PopularFunc            func(ctx context.Context, opts *reddit.ListSubredditOptions) (*reddit.Subreddits, *reddit.Response, error)
+	RandomFunc             func(ctx context.Context) (*reddit.Subreddit, *reddit.Response, error)
+	RandomNSFWFunc         func(ctx context.Context) (*reddit.Subreddit, *reddit.Response, error)
+	RisingPostsFunc        func(ctx context.Context, subreddit string, opts *reddit.ListOptions) (*reddit.Posts, *reddit.Response, error)
+	SearchFunc             func(ctx context.Context, query string, opts *reddit.ListSubredditOptions) (*reddit.Subreddits, *reddit.Response, error)
+	SearchNamesFunc        func(ctx context.Context, query string) ([]string, *reddit.Response, error)
+	SearchPostsFunc        func(ctx context.Context, query string, subreddit string, opts *reddit.ListPostSearchOptions) (*reddit.Posts, *reddit.Response, error)
+	SubmissionTextFunc     func(ctx context.Context, name string) (string, *reddit.Response, error)
+	SubscribeFunc          func(ctx context.Context, subreddits ...string) (*reddit.Response, error)
+	SubscribeByIDFunc      func(ctx context.Context, ids ...string) (*reddit.Response, error)
+	SubscribedFunc         func(ctx context.Context, opts *reddit.ListSubredditOptions) (*reddit.Subreddits, *reddit.Response, error)
+	TopPostsFunc           func(ctx context.Context, subreddit string, opts *reddit.ListPostOptions) (*reddit.Posts, *reddit.Response, error)
+	UnfavoriteFunc         func(ctx context.Context, subreddit string) (*reddit.Response, error)
+	UnsubscribeFunc        func(ctx context.Context, subreddits ...string) (*reddit.Response, error)
+	UnsubscribeByIDFunc    func(ctx context.Context, ids ...string) (*reddit.Response, error)
+	WikiBannedFunc         func(ctx context.Context, subreddit string, opts *reddit.ListOptions) (*reddit.Bans, *reddit.Response, error)
+	WikiContributorsFunc   func(ctx context.Context, subreddit string, opts *reddit.ListOptions) (*reddit.Relationships, *reddit.Response, error)
+}
+
+var _ reddit.SubredditAPI = &SubredditAPI{}
+
+// Approved records the call, and calls ApprovedFunc if it is set.
+func (m *SubredditAPI) Approved(ctx context.Context, opts *reddit.ListSubredditOptions) (r0 *reddit.Subreddits, r1 *reddit.Response, r2 error) {
+	m.record("Approved", ctx, opts)
+	if m.ApprovedFunc != nil {
+		return m.ApprovedFunc(ctx, opts)
+	}
+	return
+}
+
+// Banned records the call, and calls BannedFunc if it is set.
+func (m *SubredditAPI) Banned(ctx context.Context, subreddit string, opts *reddit.ListOptions) (r0 *reddit.Bans, r1 *reddit.Response, r2 error) {
+	m.record("Banned", ctx, subreddit, opts)
+	if m.BannedFunc != nil {
+		return m.BannedFunc(ctx, subreddit, opts)
+	}
+	return
+}
+
+// Contributors records the call, and calls ContributorsFunc if it is set.
+func (m *SubredditAPI) Contributors(ctx context.Context, subreddit string, opts *reddit.ListOptions) (r0 *reddit.Relationships, r1 *reddit.Response, r2 error) {
+	m.record("Contributors", ctx, subreddit, opts)
+	if m.ContributorsFunc != nil {
+		return m.ContributorsFunc(ctx, subreddit, opts)
+	}
+	return
+}
+
+// ControversialPosts records the call, and calls ControversialPostsFunc if it is set.
+func (m *SubredditAPI) ControversialPosts(ctx context.Context, subreddit string, opts *reddit.ListPostOptions) (r0 *reddit.Posts, r1 *reddit.Response, r2 error) {
+	m.record("ControversialPosts", ctx, subreddit, opts)
+	if m.ControversialPostsFunc != nil {
+		return m.ControversialPostsFunc(ctx, subreddit, opts)
+	}
+	return
+}
+
+// Default records the call, and calls DefaultFunc if it is set.
+func (m *SubredditAPI) Default(ctx context.Context, opts *reddit.ListSubredditOptions) (r0 *reddit.Subreddits, r1 *reddit.Response, r2 error) {
+	m.record("Default", ctx, opts)
+	if m.DefaultFunc != nil {
+		return m.DefaultFunc(ctx, opts)
+	}
+	return
+}
+
+// Favorite records the call, and calls FavoriteFunc if it is set.
+func (m *SubredditAPI) Favorite(ctx context.Context, subreddit string) (r0 *reddit.Response, r1 error) {
+	m.record("Favorite", ctx, subreddit)
+	if m.FavoriteFunc != nil {
+		return m.FavoriteFunc(ctx, subreddit)
+	}
+	return
+}
+
+// Get records the call, and calls GetFunc if it is set.
+func (m *SubredditAPI) Get(ctx context.Context, name string) (r0 *reddit.Subreddit, r1 *reddit.Response, r2 error) {
+	m.record("Get", ctx, name)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, name)
+	}
+	return
+}
+
+// GetSticky1 records the call, and calls GetSticky1Func if it is set.
+func (m *SubredditAPI) GetSticky1(ctx context.Context, subreddit string) (r0 *reddit.PostAndComments, r1 *reddit.Response, r2 error) {
+	m.record("GetSticky1", ctx, subreddit)
+	if m.GetSticky1Func != nil {
+		return m.GetSticky1Func(ctx, subreddit)
+	}
+	return
+}
+
+// GetSticky2 records the call, and calls GetSticky2Func if it is set.
+func (m *SubredditAPI) GetSticky2(ctx context.Context, subreddit string) (r0 *reddit.PostAndComments, r1 *reddit.Response, r2 error) {
+	m.record("GetSticky2", ctx, subreddit)
+	if m.GetSticky2Func != nil {
+		return m.GetSticky2Func(ctx, subreddit)
+	}
+	return
+}
+
+// Gold records the call, and calls GoldFunc if it is set.
+func (m *SubredditAPI) Gold(ctx context.Context, opts *reddit.ListSubredditOptions) (r0 *reddit.Subreddits, r1 *reddit.Response, r2 error) {
+	m.record("Gold", ctx, opts)
+	if m.GoldFunc != nil {
+		return m.GoldFunc(ctx, opts)
+	}
+	return
+}
+
+// HotPosts records the call, and calls HotPostsFunc if it is set.
+func (m *SubredditAPI) HotPosts(ctx context.Context, subreddit string, opts *reddit.ListOptions) (r0 *reddit.Posts, r1 *reddit.Response, r2 error) {
+	m.record("HotPosts", ctx, subreddit, opts)
+	if m.HotPostsFunc != nil {
+		return m.HotPostsFunc(ctx, subreddit, opts)
+	}
+	return
+}
+
+// Moderated records the call, and calls ModeratedFunc if it is set.
+func (m *SubredditAPI) Moderated(ctx context.Context, opts *reddit.ListSubredditOptions) (r0 *reddit.Subreddits, r1 *reddit.Response, r2 error) {
+	m.record("Moderated", ctx, opts)
+	if m.ModeratedFunc != nil {
+		return m.ModeratedFunc(ctx, opts)
+	}
+	return
+}
+
+// Moderators records the call, and calls ModeratorsFunc if it is set.
+func (m *SubredditAPI) Moderators(ctx context.Context, subreddit string) (r0 []*reddit.Moderator, r1 *reddit.Response, r2 error) {
+	m.record("Moderators", ctx, subreddit)
+	if m.ModeratorsFunc != nil {
+		return m.ModeratorsFunc(ctx, subreddit)
+	}
+	return
+}
+
+// Muted records the call, and calls MutedFunc if it is set.
+func (m *SubredditAPI) Muted(ctx context.Context, subreddit string, opts *reddit.ListOptions) (r0 *reddit.Relationships, r1 *reddit.Response, r2 error) {
+	m.record("Muted", ctx, subreddit, opts)
+	if m.MutedFunc != nil {
+		return m.MutedFunc(ctx, subreddit, opts)
+	}
+	return
+}
+
+// New records the call, and calls NewFunc if it is set.
+func (m *SubredditAPI) New(ctx context.Context, opts *reddit.ListSubredditOptions) (r0 *reddit.Subreddits, r1 *reddit.Response, r2 error) {
+	m.record("New", ctx, opts)
+	if m.NewFunc != nil {
+		return m.NewFunc(ctx, opts)
+	}
+	return
+}
+
+// NewPosts records the call, and calls NewPostsFunc if it is set.
+func (m *SubredditAPI) NewPosts(ctx context.Context, subreddit string, opts *reddit.ListOptions) (r0 *reddit.Posts, r1 *reddit.Response, r2 error) {
+	m.record("NewPosts", ctx, subreddit, opts)
+	if m.NewPostsFunc != nil {
+		return m.NewPostsFunc(ctx, subreddit, opts)
+	}
+	return
+}
+
+// Popular records the call, and calls PopularFunc if it is set.
+func (m *SubredditAPI) Popular(ctx context.Context, opts *reddit.ListSubredditOptions) (r0 *reddit.Subreddits, r1 *reddit.Response, r2 error) {
+	m.record("Popular", ctx, opts)
+	if m.PopularFunc != nil {
+		return m.PopularFunc(ctx, opts)
+	}
+	return
+}
+
+// Random records the call, and calls RandomFunc if it is set.
+func (m *SubredditAPI) Random(ctx context.Context) (r0 *reddit.Subreddit, r1 *reddit.Response, r2 error) {
+	m.record("Random", ctx)
+	if m.RandomFunc != nil {
+		return m.RandomFunc(ctx)
+	}
+	return
+}
+
+// RandomNSFW records the call, and calls RandomNSFWFunc if it is set.
+func (m *SubredditAPI) RandomNSFW(ctx context.Context) (r0 *reddit.Subreddit, r1 *reddit.Response, r2 error) {
+	m.record("RandomNSFW", ctx)
+	if m.RandomNSFWFunc != nil {
+		return m.RandomNSFWFunc(ctx)
+	}
+	return
+}
+
+// RisingPosts records the call, and calls RisingPostsFunc if it is set.
+func (m *SubredditAPI) RisingPosts(ctx context.Context, subreddit string, opts *reddit.ListOptions) (r0 *reddit.Posts, r1 *reddit.Response, r2 error) {
+	m.record("RisingPosts", ctx, subreddit, opts)
+	if m.RisingPostsFunc != nil {
+		return m.RisingPostsFunc(ctx, subreddit, opts)
+	}
+	return
+}
+
+// Search records the call, and calls SearchFunc if it is set.
+func (m *SubredditAPI) Search(ctx context.Context, query string, opts *reddit.ListSubredditOptions) (r0 *reddit.Subreddits, r1 *reddit.Response, r2 error) {
+	m.record("Search", ctx, query, opts)
+	if m.SearchFunc != nil {
+		return m.SearchFunc(ctx, query, opts)
+	}
+	return
+}
+
+// SearchNames records the call, and calls SearchNamesFunc if it is set.
+func (m *SubredditAPI) SearchNames(ctx context.Context, query string) (r0 []string, r1 *reddit.Response, r2 error) {
+	m.record("SearchNames", ctx, query)
+	if m.SearchNamesFunc != nil {
+		return m.SearchNamesFunc(ctx, query)
+	}
+	return
+}
+
+// SearchPosts records the call, and calls SearchPostsFunc if it is set.
+func (m *SubredditAPI) SearchPosts(ctx context.Context, query string, subreddit string, opts *reddit.ListPostSearchOptions) (r0 *reddit.Posts, r1 *reddit.Response, r2 error) {
+	m.record("SearchPosts", ctx, query, subreddit, opts)
+	if m.SearchPostsFunc != nil {
+		return m.SearchPostsFunc(ctx, query, subreddit, opts)
+	}
+	return
+}
+
+// SubmissionText records the call, and calls SubmissionTextFunc if it is set.
+func (m *SubredditAPI) SubmissionText(ctx context.Context, name string) (r0 string, r1 *reddit.Response, r2 error) {
+	m.record("SubmissionText", ctx, name)
+	if m.SubmissionTextFunc != nil {
+		return m.SubmissionTextFunc(ctx, name)
+	}
+	return
+}
+
+// Subscribe records the call, and calls SubscribeFunc if it is set.
+func (m *SubredditAPI) Subscribe(ctx context.Context, subreddits ...string) (r0 *reddit.Response, r1 error) {
+	m.record("Subscribe", ctx, subreddits)
+	if m.SubscribeFunc != nil {
+		return m.SubscribeFunc(ctx, subreddits...)
+	}
+	return
+}
+
+// SubscribeByID records the call, and calls SubscribeByIDFunc if it is set.
+func (m *SubredditAPI) SubscribeByID(ctx context.Context, ids ...string) (r0 *reddit.Response, r1 error) {
+	m.record("SubscribeByID", ctx, ids)
+	if m.SubscribeByIDFunc != nil {
+		return m.SubscribeByIDFunc(ctx, ids...)
+	}
+	return
+}
+
+// Subscribed records the call, and calls SubscribedFunc if it is set.
+func (m *SubredditAPI) Subscribed(ctx context.Context, opts *reddit.ListSubredditOptions) (r0 *reddit.Subreddits, r1 *reddit.Response, r2 error) {
+	m.record("Subscribed", ctx, opts)
+	if m.SubscribedFunc != nil {
+		return m.SubscribedFunc(ctx, opts)
+	}
+	return
+}
+
+// TopPosts records the call, and calls TopPostsFunc if it is set.
+func (m *SubredditAPI) TopPosts(ctx context.Context, subreddit string, opts *reddit.ListPostOptions) (r0 *reddit.Posts, r1 *reddit.Response, r2 error) {
+	m.record("TopPosts", ctx, subreddit, opts)
+	if m.TopPostsFunc != nil {
+		return m.TopPostsFunc(ctx, subreddit, opts)
+	}
+	return
+}
+
+// Unfavorite records the call, and calls UnfavoriteFunc if it is set.
+func (m *SubredditAPI) Unfavorite(ctx context.Context, subreddit string) (r0 *reddit.Response, r1 error) {
+	m.record("Unfavorite", ctx, subreddit)
+	if m.UnfavoriteFunc != nil {
+		return m.UnfavoriteFunc(ctx, subreddit)
+	}
+	return
+}
+
+// Unsubscribe records the call, and calls UnsubscribeFunc if it is set.
+func (m *SubredditAPI) Unsubscribe(ctx context.Context, subreddits ...string) (r0 *reddit.Response, r1 error) {
+	m.record("Unsubscribe", ctx, subreddits)
+	if m.UnsubscribeFunc != nil {
+		return m.UnsubscribeFunc(ctx, subreddits...)
+	}
+	return
+}
+
+// UnsubscribeByID records the call, and calls UnsubscribeByIDFunc if it is set.
+func (m *SubredditAPI) UnsubscribeByID(ctx context.Context, ids ...string) (r0 *reddit.Response, r1 error) {
+	m.record("UnsubscribeByID", ctx, ids)
+	if m.UnsubscribeByIDFunc != nil {
+		return m.UnsubscribeByIDFunc(ctx, ids...)
+	}
+	return
+}
+
+// WikiBanned records the call, and calls WikiBannedFunc if it is set.
+func (m *SubredditAPI) WikiBanned(ctx context.Context, subreddit string, opts *reddit.ListOptions) (r0 *reddit.Bans, r1 *reddit.Response, r2 error) {
+	m.record("WikiBanned", ctx, subreddit, opts)
+	if m.WikiBannedFunc != nil {
+		return m.WikiBannedFunc(ctx, subreddit, opts)
+	}
+	return
+}
+
+// WikiContributors records the call, and calls WikiContributorsFunc if it is set.
+func (m *SubredditAPI) WikiContributors(ctx context.Context, subreddit string, opts *reddit.ListOptions) (r0 *reddit.Relationships, r1 *reddit.Response, r2 error) {
+	m.record("WikiContributors", ctx, subreddit, opts)
+	if m.WikiContributorsFunc != nil {
+		return m.WikiContributorsFunc(ctx, subreddit, opts)
+	}
+	return
+}
+
+// UserAPI is a mock of reddit.UserAPI.
+// Its methods record their calls, and then call the function field of the same name (e.g. GetFunc for Get),
+// if set. Otherwise, they return zero values.
+type UserAPI struct {
+	Recorder
+
+	BlockFunc             func(ctx context.Context, username string) (*reddit.Blocked, *reddit.Response, error)
+	BlockByIDFunc         func(ctx context.Context, id string) (*reddit.Blocked, *reddit.Response, error)
+	CommentsFunc          func(ctx context.Context, opts *reddit.ListUserOverviewOptions) (*reddit.Comments, *reddit.Response, error)
+	CommentsOfFunc        func(ctx context.Context, username string, opts *reddit.ListUserOverviewOptions) (*reddit.Comments, *reddit.Response, error)
+	DownvotedFunc         func(ctx context.Context, opts *reddit.ListUserOverviewOptions) (*reddit.Posts, *reddit.Response, error)
+	DownvotedOfFunc       func(ctx context.Context, username string, opts *reddit.ListUserOverviewOptions) (*reddit.Posts, *reddit.Response, error)
+	FriendFunc            func(ctx context.Context, username string) (*reddit.Relationship, *reddit.Response, error)
+	GetFunc               func(ctx context.Context, username string) (*reddit.User, *reddit.Response, error)
+	GetFriendshipFunc     func(ctx context.Context, username string) (*reddit.Relationship, *reddit.Response, error)
+	GetMultipleByIDFunc   func(ctx context.Context, ids ...string) (map[string]*reddit.UserSummary, *reddit.Response, error)
+	GildedFunc            func(ctx context.Context, opts *reddit.ListUserOverviewOptions) (*reddit.Posts, *reddit.Response, error)
+	HiddenFunc            func(ctx context.Context, opts *reddit.ListUserOverviewOptions) (*reddit.Posts, *reddit.Response, error)
+	NewFunc               func(ctx context.Context, opts *reddit.ListUserOverviewOptions) (*reddit.Subreddits, *reddit.Response, error)
+	OverviewFunc          func(ctx context.Context, opts *reddit.ListUserOverviewOptions) (*reddit.Posts, *reddit.Comments, *reddit.Response, error)
+	OverviewOfFunc        func(ctx context.Context, username string, opts *reddit.ListUserOverviewOptions) (*reddit.Posts, *reddit.Comments, *reddit.Response, error)
+	PopularFunc           func(ctx context.Context, opts *reddit.ListOptions) (*reddit.Subreddits, *reddit.Response, error)
+	PostsFunc             func(ctx context.Context, opts *reddit.ListUserOverviewOptions) (*reddit.Posts, *reddit.Response, error)
+	PostsOfFunc           func(ctx context.Context, username string, opts *reddit.ListUserOverviewOptions) (*reddit.Posts, *reddit.Response, error)
+	SavedFunc             func(ctx context.Context, opts *reddit.ListUserOverviewOptions) (*reddit.Posts, *reddit.Comments, *reddit.Response, error)
+	SearchFunc            func(ctx context.Context, query string, opts *reddit.ListOptions) (*reddit.Users, *reddit.Response, error)
+	TrophiesFunc          func(ctx context.Context) ([]reddit.Trophy, *reddit.Response, error)
+	TrophiesOfFunc        func(ctx context.Context, username string) ([]reddit.Trophy, *reddit.Response, error)
+	UnblockFunc           func(ctx context.Context, username string) (*reddit.Response, error)
+	UnblockByIDFunc       func(ctx context.Context, id string) (*reddit.Response, error)
+	UnfriendFunc          func(ctx context.Context, username string) (*reddit.Response, error)
+	UpvotedFunc           func(ctx context.Context, opts *reddit.ListUserOverviewOptions) (*reddit.Posts, *reddit.Response, error)
+	UpvotedOfFunc         func(ctx context.Context, username string, opts *reddit.ListUserOverviewOptions) (*reddit.Posts, *reddit.Response, error)
+	UsernameAvailableFunc func(ctx context.Context, username string) (bool, *reddit.Response, error)
+}
+
+var _ reddit.UserAPI = &UserAPI{}
+
+// Block records the call, and calls BlockFunc if it is set.
+func (m *UserAPI) Block(ctx context.Context, username string) (r0 *reddit.Blocked, r1 *reddit.Response, r2 error) {
+	m.record("Block", ctx, username)
+	if m.BlockFunc != nil {
+		return m.BlockFunc(ctx, username)
+	}
+	return
+}
+
+// BlockByID records the call, and calls BlockByIDFunc if it is set.
+func (m *UserAPI) BlockByID(ctx context.Context, id string) (r0 *reddit.Blocked, r1 *reddit.Response, r2 error) {
+	m.record("BlockByID", ctx, id)
+	if m.BlockByIDFunc != nil {
+		return m.BlockByIDFunc(ctx, id)
+	}
+	return
+}
+
+// Comments records the call, and calls CommentsFunc if it is set.
+func (m *UserAPI) Comments(ctx context.Context, opts *reddit.ListUserOverviewOptions) (r0 *reddit.Comments, r1 *reddit.Response, r2 error) {
+	m.record("Comments", ctx, opts)
+	if m.CommentsFunc != nil {
+		return m.CommentsFunc(ctx, opts)
+	}
+	return
+}
+
+// CommentsOf records the call, and calls CommentsOfFunc if it is set.
+func (m *UserAPI) CommentsOf(ctx context.Context, username string, opts *reddit.ListUserOverviewOptions) (r0 *reddit.Comments, r1 *reddit.Response, r2 error) {
+	m.record("CommentsOf", ctx, username, opts)
+	if m.CommentsOfFunc != nil {
+		return m.CommentsOfFunc(ctx, username, opts)
+	}
+	return
+}
+
+// Downvoted records the call, and calls DownvotedFunc if it is set.
+func (m *UserAPI) Downvoted(ctx context.Context, opts *reddit.ListUserOverviewOptions) (r0 *reddit.Posts, r1 *reddit.Response, r2 error) {
+	m.record("Downvoted", ctx, opts)
+	if m.DownvotedFunc != nil {
+		return m.DownvotedFunc(ctx, opts)
+	}
+	return
+}
+
+// DownvotedOf records the call, and calls DownvotedOfFunc if it is set.
+func (m *UserAPI) DownvotedOf(ctx context.Context, username string, opts *reddit.ListUserOverviewOptions) (r0 *reddit.Posts, r1 *reddit.Response, r2 error) {
+	m.record("DownvotedOf", ctx, username, opts)
+	if m.DownvotedOfFunc != nil {
+		return m.DownvotedOfFunc(ctx, username, opts)
+	}
+	return
+}
+
+// Friend records the call, and calls FriendFunc if it is set.
+func (m *UserAPI) Friend(ctx context.Context, username string) (r0 *reddit.Relationship, r1 *reddit.Response, r2 error) {
+	m.record("Friend", ctx, username)
+	if m.FriendFunc != nil {
+		return m.FriendFunc(ctx, username)
+	}
+	return
+}
+
+// Get records the call, and calls GetFunc if it is set.
+func (m *UserAPI) Get(ctx context.Context, username string) (r0 *reddit.User, r1 *reddit.Response, r2 error) {
+	m.record("Get", ctx, username)
+	if m.GetFunc != nil {
+		return m.GetFunc(ctx, username)
+	}
+	return
+}
+
+// GetFriendship records the call, and calls GetFriendshipFunc if it is set.
+func (m *UserAPI) GetFriendship(ctx context.Context, username string) (r0 *reddit.Relationship, r1 *reddit.Response, r2 error) {
+	m.record("GetFriendship", ctx, username)
+	if m.GetFriendshipFunc != nil {
+		return m.GetFriendshipFunc(ctx, username)
+	}
+	return
+}
+
+// GetMultipleByID records the call, and calls GetMultipleByIDFunc if it is set.
+func (m *UserAPI) GetMultipleByID(ctx context.Context, ids ...string) (r0 map[string]*reddit.UserSummary, r1 *reddit.Response, r2 error) {
+	m.record("GetMultipleByID", ctx, ids)
+	if m.GetMultipleByIDFunc != nil {
+		return m.GetMultipleByIDFunc(ctx, ids...)
+	}
+	return
+}
+
+// Gilded records the call, and calls GildedFunc if it is set.
+func (m *UserAPI) Gilded(ctx context.Context, opts *reddit.ListUserOverviewOptions) (r0 *reddit.Posts, r1 *reddit.Response, r2 error) {
+	m.record("Gilded", ctx, opts)
+	if m.GildedFunc != nil {
+		return m.GildedFunc(ctx, opts)
+	}
+	return
+}
+
+// Hidden records the call, and calls HiddenFunc if it is set.
+func (m *UserAPI) Hidden(ctx context.Context, opts *reddit.ListUserOverviewOptions) (r0 *reddit.Posts, r1 *reddit.Response, r2 error) {
+	m.record("Hidden", ctx, opts)
+	if m.HiddenFunc != nil {
+		return m.HiddenFunc(ctx, opts)
+	}
+	return
+}
+
+// New records the call, and calls NewFunc if it is set.
+func (m *UserAPI) New(ctx context.Context, opts *reddit.ListUserOverviewOptions) (r0 *reddit.Subreddits, r1 *reddit.Response, r2 error) {
+	m.record("New", ctx, opts)
+	if m.NewFunc != nil {
+		return m.NewFunc(ctx, opts)
+	}
+	return
+}
+
+// Overview records the call, and calls OverviewFunc if it is set.
+func (m *UserAPI) Overview(ctx context.Context, opts *reddit.ListUserOverviewOptions) (r0 *reddit.Posts, r1 *reddit.Comments, r2 *reddit.Response, r3 error) {
+	m.record("Overview", ctx, opts)
+	if m.OverviewFunc != nil {
+		return m.OverviewFunc(ctx, opts)
+	}
+	return
+}
+
+// OverviewOf records the call, and calls OverviewOfFunc if it is set.
+func (m *UserAPI) OverviewOf(ctx context.Context, username string, opts *reddit.ListUserOverviewOptions) (r0 *reddit.Posts, r1 *reddit.Comments, r2 *reddit.Response, r3 error) {
+	m.record("OverviewOf", ctx, username, opts)
+	if m.OverviewOfFunc != nil {
+		return m.OverviewOfFunc(ctx, username, opts)
+	}
+	return
+}
+
+// Popular records the call, and calls PopularFunc if it is set.
+func (m *UserAPI) Popular(ctx context.Context, opts *reddit.ListOptions) (r0 *reddit.Subreddits, r1 *reddit.Response, r2 error) {
+	m.record("Popular", ctx, opts)
+	if m.PopularFunc != nil {
+		return m.PopularFunc(ctx, opts)
+	}
+	return
+}
+
+// Posts records the call, and calls PostsFunc if it is set.
+func (m *UserAPI) Posts(ctx context.Context, opts *reddit.ListUserOverviewOptions) (r0 *reddit.Posts, r1 *reddit.Response, r2 error) {
+	m.record("Posts", ctx, opts)
+	if m.PostsFunc != nil {
+		return m.PostsFunc(ctx, opts)
+	}
+	return
+}
+
+// PostsOf records the call, and calls PostsOfFunc if it is set.
+func (m *UserAPI) PostsOf(ctx context.Context, username string, opts *reddit.ListUserOverviewOptions) (r0 *reddit.Posts, r1 *reddit.Response, r2 error) {
+	m.record("PostsOf", ctx, username, opts)
+	if m.PostsOfFunc != nil {
+		return m.PostsOfFunc(ctx, username, opts)
+	}
+	return
+}
+
+// Saved records the call, and calls SavedFunc if it is set.
+func (m *UserAPI) Saved(ctx context.Context, opts *reddit.ListUserOverviewOptions) (r0 *reddit.Posts, r1 *reddit.Comments, r2 *reddit.Response, r3 error) {
+	m.record("Saved", ctx, opts)
+	if m.SavedFunc != nil {
+		return m.SavedFunc(ctx, opts)
+	}
+	return
+}
+
+// Search records the call, and calls SearchFunc if it is set.
+func (m *UserAPI) Search(ctx context.Context, query string, opts *reddit.ListOptions) (r0 *reddit.Users, r1 *reddit.Response, r2 error) {
+	m.record("Search", ctx, query, opts)
+	if m.SearchFunc != nil {
+		return m.SearchFunc(ctx, query, opts)
+	}
+	return
+}
+
+// Trophies records the call, and calls TrophiesFunc if it is set.
+func (m *UserAPI) Trophies(ctx context.Context) (r0 []reddit.Trophy, r1 *reddit.Response, r2 error) {
+	m.record("Trophies", ctx)
+	if m.TrophiesFunc != nil {
+		return m.TrophiesFunc(ctx)
+	}
+	return
+}
+
+// TrophiesOf records the call, and calls TrophiesOfFunc if it is set.
+func (m *UserAPI) TrophiesOf(ctx context.Context, username string) (r0 []reddit.Trophy, r1 *reddit.Response, r2 error) {
+	m.record("TrophiesOf", ctx, username)
+	if m.TrophiesOfFunc != nil {
+		return m.TrophiesOfFunc(ctx, username)
+	}
+	return
+}
+
+// Unblock records the call, and calls UnblockFunc if it is set.
+func (m *UserAPI) Unblock(ctx context.Context, username string) (r0 *reddit.Response, r1 error) {
+	m.record("Unblock", ctx, username)
+	if m.UnblockFunc != nil {
+		return m.UnblockFunc(ctx, username)
+	}
+	return
+}
+
+// UnblockByID records the call, and calls UnblockByIDFunc if it is set.
+func (m *UserAPI) UnblockByID(ctx context.Context, id string) (r0 *reddit.Response, r1 error) {
+	m.record("UnblockByID", ctx, id)
+	if m.UnblockByIDFunc != nil {
+		return m.UnblockByIDFunc(ctx, id)
+	}
+	return
+}
+
+// Unfriend records the call, and calls UnfriendFunc if it is set.
+func (m *UserAPI) Unfriend(ctx context.Context, username string) (r0 *reddit.Response, r1 error) {
+	m.record("Unfriend", ctx, username)
+	if m.UnfriendFunc != nil {
+		return m.UnfriendFunc(ctx, username)
+	}
+	return
+}
+
+// Upvoted records the call, and calls UpvotedFunc if it is set.
+func (m *UserAPI) Upvoted(ctx context.Context, opts *reddit.ListUserOverviewOptions) (r0 *reddit.Posts, r1 *reddit.Response, r2 error) {
+	m.record("Upvoted", ctx, opts)
+	if m.UpvotedFunc != nil {
+		return m.UpvotedFunc(ctx, opts)
+	}
+	return
+}
+
+// UpvotedOf records the call, and calls UpvotedOfFunc if it is set.
+func (m *UserAPI) UpvotedOf(ctx context.Context, username string, opts *reddit.ListUserOverviewOptions) (r0 *reddit.Posts, r1 *reddit.Response, r2 error) {
+	m.record("UpvotedOf", ctx, username, opts)
+	if m.UpvotedOfFunc != nil {
+		return m.UpvotedOfFunc(ctx, username, opts)
+	}
+	return
+}
+
+// UsernameAvailable records the call, and calls UsernameAvailableFunc if it is set.
+func (m *UserAPI) UsernameAvailable(ctx context.Context, username string) (r0 bool, r1 *reddit.Response, r2 error) {
+	m.record("UsernameAvailable", ctx, username)
+	if m.UsernameAvailableFunc != nil {
+		return m.UsernameAvailableFunc(ctx, username)
+	}
+	return
+}
@@ -0,0 +1,705 @@
+package reddittest
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Sorts of post listings.
+var postSorts = map[string]bool{
+	"hot":           true,
+	"new":           true,
+	"top":           true,
+	"rising":        true,
+	"controversial": true,
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.Trim(r.URL.Path, "/")
+	path = strings.TrimSuffix(path, ".json")
+
+	if path == "api/v1/access_token" {
+		s.handleAccessToken(w, r)
+		return
+	}
+
+	if r.Header.Get("Authorization") != "Bearer "+accessToken {
+		writeError(w, http.StatusUnauthorized)
+		return
+	}
+
+	if err := r.ParseForm(); err != nil {
+		writeError(w, http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	segments := strings.Split(path, "/")
+
+	switch {
+	case r.Method == http.MethodGet:
+		s.serveGet(w, r, segments)
+	case r.Method == http.MethodPost && len(segments) == 2 && segments[0] == "api":
+		s.servePost(w, r, segments[1])
+	default:
+		writeError(w, http.StatusNotFound)
+	}
+}
+
+func (s *Server) serveGet(w http.ResponseWriter, r *http.Request, segments []string) {
+	switch {
+	case len(segments) == 3 && segments[0] == "api" && segments[1] == "v1" && segments[2] == "me":
+		s.handleMe(w, r)
+	case len(segments) == 2 && segments[0] == "api" && segments[1] == "info":
+		s.handleInfo(w, r)
+	case len(segments) == 2 && segments[0] == "by_id":
+		s.handleByID(w, r, strings.Split(segments[1], ","))
+	case len(segments) >= 2 && segments[0] == "comments":
+		s.handlePost(w, r, segments[1])
+	case len(segments) == 2 && segments[0] == "message":
+		s.handleInbox(w, r, segments[1])
+	case len(segments) == 1 && postSorts[segments[0]]:
+		s.handlePosts(w, r, "all", segments[0])
+	case len(segments) == 2 && segments[0] == "r":
+		s.handlePosts(w, r, segments[1], "hot")
+	case len(segments) == 3 && segments[0] == "r" && postSorts[segments[2]]:
+		s.handlePosts(w, r, segments[1], segments[2])
+	case len(segments) == 3 && segments[0] == "r" && segments[2] == "comments":
+		s.handleSubredditComments(w, r, segments[1])
+	case len(segments) == 3 && segments[0] == "r" && segments[2] == "about":
+		s.handleAbout(w, r, segments[1])
+	case len(segments) == 4 && segments[0] == "r" && segments[2] == "about" && segments[3] == "log":
+		s.handleModLog(w, r, segments[1])
+	default:
+		writeError(w, http.StatusNotFound)
+	}
+}
+
+func (s *Server) servePost(w http.ResponseWriter, r *http.Request, endpoint string) {
+	switch endpoint {
+	case "submit":
+		s.handleSubmit(w, r)
+	case "comment":
+		s.handleComment(w, r)
+	case "morechildren":
+		s.handleMoreChildren(w, r)
+	case "editusertext":
+		s.handleEdit(w, r)
+	case "del":
+		s.handleDelete(w, r)
+	case "vote":
+		s.handleVote(w, r)
+	case "remove", "approve", "lock", "unlock":
+		s.handleModerate(w, r, endpoint)
+	case "compose":
+		s.handleCompose(w, r)
+	case "read_message", "unread_message":
+		s.handleRead(w, r, endpoint == "read_message")
+	case "read_all_messages":
+		for _, m := range s.inbox(s.username, nil) {
+			m.unread = false
+		}
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		writeError(w, http.StatusNotFound)
+	}
+}
+
+func (s *Server) handleAccessToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed)
+		return
+	}
+	writeJSON(w, map[string]interface{}{
+		"access_token": accessToken,
+		"token_type":   "bearer",
+		"expires_in":   3600,
+		"scope":        "*",
+	})
+}
+
+func (s *Server) handleMe(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, map[string]interface{}{
+		"id":                 s.userID(s.username),
+		"name":               s.username,
+		"created_utc":        unix(time.Now()),
+		"link_karma":         0,
+		"comment_karma":      0,
+		"has_verified_email": true,
+	})
+}
+
+func (s *Server) handleInfo(w http.ResponseWriter, r *http.Request) {
+	var items []item
+
+	switch {
+	case r.Form.Get("id") != "":
+		for _, id := range strings.Split(r.Form.Get("id"), ",") {
+			if it := s.thing(id); it != nil {
+				items = append(items, it)
+			}
+		}
+	case r.Form.Get("url") != "":
+		for _, p := range s.sortedPosts() {
+			if p.url == r.Form.Get("url") && !p.removed {
+				items = append(items, p)
+			}
+		}
+	case r.Form.Get("sr_name") != "":
+		for _, name := range strings.Split(r.Form.Get("sr_name"), ",") {
+			if sr, ok := s.subreddits[strings.ToLower(name)]; ok {
+				items = append(items, sr)
+			}
+		}
+	}
+
+	writeJSON(w, s.listing(r, items))
+}
+
+func (s *Server) handleByID(w http.ResponseWriter, r *http.Request, ids []string) {
+	var items []item
+	for _, id := range ids {
+		if p, ok := s.thing(id).(*post); ok {
+			items = append(items, p)
+		}
+	}
+	writeJSON(w, s.listing(r, items))
+}
+
+func (s *Server) handlePost(w http.ResponseWriter, r *http.Request, id string) {
+	p, ok := s.posts[id]
+	if !ok {
+		writeError(w, http.StatusNotFound)
+		return
+	}
+
+	var topLevel []*comment
+	for _, c := range s.comments {
+		if c.parentID == p.fullID() {
+			topLevel = append(topLevel, c)
+		}
+	}
+	sort.Slice(topLevel, func(i, j int) bool {
+		return topLevel[i].seq < topLevel[j].seq
+	})
+
+	writeJSON(w, []interface{}{
+		listingOf([]interface{}{thingOf(p.kind(), p.data(s))}, "", ""),
+		listingOf(s.replies(p.fullID(), topLevel, 0), "", ""),
+	})
+}
+
+func (s *Server) handlePosts(w http.ResponseWriter, r *http.Request, subreddits, sortBy string) {
+	match, ok := s.matchSubreddits(subreddits)
+	if !ok {
+		writeError(w, http.StatusNotFound)
+		return
+	}
+
+	var posts []*post
+	for _, p := range s.sortedPosts() {
+		if match(p.subreddit) && !p.removed {
+			posts = append(posts, p)
+		}
+	}
+	if sortBy != "new" {
+		sort.SliceStable(posts, func(i, j int) bool {
+			return posts[i].score > posts[j].score
+		})
+	}
+
+	items := make([]item, len(posts))
+	for i, p := range posts {
+		items[i] = p
+	}
+	writeJSON(w, s.listing(r, items))
+}
+
+func (s *Server) handleSubredditComments(w http.ResponseWriter, r *http.Request, subreddits string) {
+	match, ok := s.matchSubreddits(subreddits)
+	if !ok {
+		writeError(w, http.StatusNotFound)
+		return
+	}
+
+	var items []item
+	for _, c := range s.sortedComments() {
+		if match(c.post.subreddit) && !c.removed {
+			items = append(items, c)
+		}
+	}
+	writeJSON(w, s.listing(r, items))
+}
+
+func (s *Server) handleAbout(w http.ResponseWriter, r *http.Request, name string) {
+	sr, ok := s.subreddits[strings.ToLower(name)]
+	if !ok {
+		writeError(w, http.StatusNotFound)
+		return
+	}
+	writeJSON(w, thingOf(sr.kind(), sr.data(s)))
+}
+
+func (s *Server) handleModLog(w http.ResponseWriter, r *http.Request, name string) {
+	sr, ok := s.subreddits[strings.ToLower(name)]
+	if !ok {
+		writeError(w, http.StatusNotFound)
+		return
+	}
+	if !sr.isModerator(s.username) {
+		writeError(w, http.StatusForbidden)
+		return
+	}
+
+	var items []item
+	for i := len(s.modActions) - 1; i >= 0; i-- {
+		a := s.modActions[i]
+		if a.subreddit != sr {
+			continue
+		}
+		if typ := r.Form.Get("type"); typ != "" && a.action != typ {
+			continue
+		}
+		if mod := r.Form.Get("mod"); mod != "" && !strings.EqualFold(a.moderator, mod) {
+			continue
+		}
+		items = append(items, a)
+	}
+	writeJSON(w, s.listing(r, items))
+}
+
+func (s *Server) handleInbox(w http.ResponseWriter, r *http.Request, where string) {
+	var filter func(m *message) bool
+
+	switch where {
+	case "inbox":
+	case "unread":
+		filter = func(m *message) bool { return m.unread }
+	case "messages":
+		filter = func(m *message) bool { return m.typ == messagePrivate }
+	case "comments":
+		filter = func(m *message) bool { return m.typ == messagePostReply || m.typ == messageCommentReply }
+	case "selfreply":
+		filter = func(m *message) bool { return m.typ == messagePostReply }
+	case "mentions":
+		filter = func(m *message) bool { return m.typ == messageMention }
+	case "sent":
+		var items []item
+		for _, m := range s.sent(s.username) {
+			items = append(items, m)
+		}
+		writeJSON(w, s.listing(r, items))
+		return
+	default:
+		writeError(w, http.StatusNotFound)
+		return
+	}
+
+	var items []item
+	for _, m := range s.inbox(s.username, filter) {
+		items = append(items, m)
+	}
+	writeJSON(w, s.listing(r, items))
+}
+
+func (s *Server) handleSubmit(w http.ResponseWriter, r *http.Request) {
+	name := r.Form.Get("sr")
+	if name == "" {
+		writeAPIError(w, "SUBREDDIT_REQUIRED", "you must specify a subreddit", "sr")
+		return
+	}
+	sr, ok := s.subreddits[strings.ToLower(name)]
+	if !ok {
+		writeAPIError(w, "SUBREDDIT_NOEXIST", "that subreddit doesn't exist", "sr")
+		return
+	}
+	if r.Form.Get("title") == "" {
+		writeAPIError(w, "NO_TEXT", "we need something here", "title")
+		return
+	}
+
+	isLink := r.Form.Get("kind") == "link"
+	if isLink && r.Form.Get("url") == "" {
+		writeAPIError(w, "NO_URL", "a url is required", "url")
+		return
+	}
+
+	p := s.newPost(sr, s.username, r.Form.Get("title"))
+	if isLink {
+		p.url = r.Form.Get("url")
+	} else {
+		p.body = r.Form.Get("text")
+		p.isSelf = true
+		p.url = "https://www.reddit.com" + p.permalink()
+	}
+
+	writeJSON(w, map[string]interface{}{
+		"json": map[string]interface{}{
+			"errors": []interface{}{},
+			"data": map[string]interface{}{
+				"url":          "https://www.reddit.com" + p.permalink(),
+				"drafts_count": 0,
+				"id":           p.id,
+				"name":         p.fullID(),
+			},
+		},
+	})
+}
+
+func (s *Server) handleComment(w http.ResponseWriter, r *http.Request) {
+	if r.Form.Get("text") == "" {
+		writeAPIError(w, "NO_TEXT", "we need something here", "text")
+		return
+	}
+
+	c, err := s.newComment(r.Form.Get("parent"), s.username, r.Form.Get("text"))
+	if err != nil {
+		writeAPIError(w, "NO_THING_ID", "couldn't find that thing", "parent")
+		return
+	}
+
+	writeJSON(w, c.data(s))
+}
+
+func (s *Server) handleMoreChildren(w http.ResponseWriter, r *http.Request) {
+	var things []interface{}
+	for _, id := range strings.Split(r.Form.Get("children"), ",") {
+		c, ok := s.comments[id]
+		if !ok || c.post.fullID() != r.Form.Get("link_id") || c.removed {
+			continue
+		}
+		things = append(things, s.commentTree(c, 0))
+	}
+
+	writeJSON(w, map[string]interface{}{
+		"json": map[string]interface{}{
+			"errors": []interface{}{},
+			"data": map[string]interface{}{
+				"things": things,
+			},
+		},
+	})
+}
+
+func (s *Server) handleEdit(w http.ResponseWriter, r *http.Request) {
+	switch thing := s.thing(r.Form.Get("thing_id")).(type) {
+	case *post:
+		if thing.author != s.username || !thing.isSelf {
+			writeError(w, http.StatusForbidden)
+			return
+		}
+		thing.body = r.Form.Get("text")
+		thing.edited = time.Now()
+		writeJSON(w, thing.data(s))
+	case *comment:
+		if thing.author != s.username {
+			writeError(w, http.StatusForbidden)
+			return
+		}
+		thing.body = r.Form.Get("text")
+		thing.edited = time.Now()
+		writeJSON(w, thing.data(s))
+	default:
+		writeAPIError(w, "NO_THING_ID", "couldn't find that thing", "thing_id")
+	}
+}
+
+func (s *Server) handleDelete(w http.ResponseWriter, r *http.Request) {
+	switch thing := s.thing(r.Form.Get("id")).(type) {
+	case *post:
+		if thing.author == s.username {
+			thing.deleted = true
+		}
+	case *comment:
+		if thing.author == s.username {
+			thing.deleted = true
+		}
+	}
+	writeJSON(w, map[string]interface{}{})
+}
+
+func (s *Server) handleVote(w http.ResponseWriter, r *http.Request) {
+	id := r.Form.Get("id")
+	dir, err := strconv.Atoi(r.Form.Get("dir"))
+	if err != nil || dir < -1 || dir > 1 {
+		writeError(w, http.StatusBadRequest)
+		return
+	}
+
+	diff := dir - s.votes[id]
+	switch thing := s.thing(id).(type) {
+	case *post:
+		thing.score += diff
+	case *comment:
+		thing.score += diff
+	default:
+		writeError(w, http.StatusBadRequest)
+		return
+	}
+
+	s.votes[id] = dir
+	writeJSON(w, map[string]interface{}{})
+}
+
+func (s *Server) handleModerate(w http.ResponseWriter, r *http.Request, endpoint string) {
+	var (
+		sr        *subreddit
+		target    item
+		suffix    string
+		setStatus func(removed, spam, locked bool)
+		status    func() (removed, spam, locked bool)
+	)
+
+	switch thing := s.thing(r.Form.Get("id")).(type) {
+	case *post:
+		sr, target, suffix = thing.subreddit, thing, "link"
+		status = func() (bool, bool, bool) { return thing.removed, thing.spam, thing.locked }
+		setStatus = func(removed, spam, locked bool) { thing.removed, thing.spam, thing.locked = removed, spam, locked }
+	case *comment:
+		sr, target, suffix = thing.post.subreddit, thing, "comment"
+		status = func() (bool, bool, bool) { return thing.removed, thing.spam, thing.locked }
+		setStatus = func(removed, spam, locked bool) { thing.removed, thing.spam, thing.locked = removed, spam, locked }
+	default:
+		writeError(w, http.StatusBadRequest)
+		return
+	}
+
+	if !sr.isModerator(s.username) {
+		writeError(w, http.StatusForbidden)
+		return
+	}
+
+	removed, spam, locked := status()
+	switch endpoint {
+	case "remove":
+		spam = r.Form.Get("spam") == "true"
+		removed = true
+		if spam {
+			s.addModAction(sr, "spam"+suffix, target, "")
+		} else {
+			s.addModAction(sr, "remove"+suffix, target, "")
+		}
+	case "approve":
+		removed, spam = false, false
+		s.addModAction(sr, "approve"+suffix, target, "")
+	case "lock":
+		locked = true
+		s.addModAction(sr, "lock", target, "")
+	case "unlock":
+		locked = false
+		s.addModAction(sr, "unlock", target, "")
+	}
+	setStatus(removed, spam, locked)
+
+	writeJSON(w, map[string]interface{}{})
+}
+
+func (s *Server) handleCompose(w http.ResponseWriter, r *http.Request) {
+	if r.Form.Get("to") == "" {
+		writeAPIError(w, "NO_USER", "please enter a username", "to")
+		return
+	}
+	s.newMessage(messagePrivate, s.username, r.Form.Get("to"), r.Form.Get("subject"), r.Form.Get("text"), nil)
+	writeJSON(w, map[string]interface{}{
+		"json": map[string]interface{}{
+			"errors": []interface{}{},
+		},
+	})
+}
+
+func (s *Server) handleRead(w http.ResponseWriter, r *http.Request, read bool) {
+	ids := make(map[string]bool)
+	for _, id := range strings.Split(r.Form.Get("id"), ",") {
+		ids[id] = true
+	}
+	for _, m := range s.inbox(s.username, nil) {
+		if ids[m.fullID()] {
+			m.unread = !read
+		}
+	}
+	writeJSON(w, map[string]interface{}{})
+}
+
+// sent returns the private messages sent by the user, newest first.
+func (s *Server) sent(username string) []*message {
+	var messages []*message
+	for _, m := range s.messages {
+		if m.typ == messagePrivate && strings.EqualFold(m.author, username) {
+			messages = append(messages, m)
+		}
+	}
+	sort.Slice(messages, func(i, j int) bool {
+		return messages[i].seq > messages[j].seq
+	})
+	return messages
+}
+
+// matchSubreddits returns a function that reports whether a subreddit is in the
+// specification, e.g. "golang", "golang+rust", or "all". It reports false if one
+// of the subreddits doesn't exist.
+func (s *Server) matchSubreddits(spec string) (func(sr *subreddit) bool, bool) {
+	if spec == "all" || spec == "popular" {
+		return func(*subreddit) bool { return true }, true
+	}
+
+	names := make(map[string]bool)
+	for _, name := range strings.Split(spec, "+") {
+		name = strings.ToLower(name)
+		if _, ok := s.subreddits[name]; !ok {
+			return nil, false
+		}
+		names[name] = true
+	}
+
+	return func(sr *subreddit) bool { return names[strings.ToLower(sr.name)] }, true
+}
+
+// replies returns the comments as things, with their replies. At most replyLimit comments are
+// returned, the rest are left out in a "more" thing.
+func (s *Server) replies(parentID string, comments []*comment, depth int) []interface{} {
+	var visible []*comment
+	for _, c := range comments {
+		if !c.removed {
+			visible = append(visible, c)
+		}
+	}
+
+	var things []interface{}
+	for i, c := range visible {
+		if i == s.replyLimit {
+			rest := visible[i:]
+			children := make([]string, len(rest))
+			for j, c := range rest {
+				children[j] = c.id
+			}
+			things = append(things, thingOf(kindMore, map[string]interface{}{
+				"count":     len(rest),
+				"name":      kindComment + "_" + rest[0].id,
+				"id":        rest[0].id,
+				"parent_id": parentID,
+				"depth":     depth,
+				"children":  children,
+			}))
+			break
+		}
+		things = append(things, s.commentTree(c, depth))
+	}
+	return things
+}
+
+// commentTree returns the comment as a thing, with its replies.
+func (s *Server) commentTree(c *comment, depth int) map[string]interface{} {
+	data := c.data(s)
+	if replies := s.replies(c.fullID(), c.replies, depth+1); len(replies) > 0 {
+		data["replies"] = listingOf(replies, "", "")
+	}
+	return thingOf(c.kind(), data)
+}
+
+// listing returns a page of the items as a listing, based on the limit, after, and before
+// parameters of the request.
+func (s *Server) listing(r *http.Request, items []item) map[string]interface{} {
+	limit := 25
+	if l, err := strconv.Atoi(r.Form.Get("limit")); err == nil && l > 0 {
+		limit = l
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	index := func(fullID string) int {
+		for i, it := range items {
+			if it.fullID() == fullID {
+				return i
+			}
+		}
+		return -1
+	}
+
+	start, end := 0, len(items)
+	if after := r.Form.Get("after"); after != "" {
+		start = index(after) + 1
+		if start == 0 {
+			start = len(items)
+		}
+	} else if before := r.Form.Get("before"); before != "" {
+		end = index(before)
+		if end < 0 {
+			end = 0
+		}
+		start = end - limit
+		if start < 0 {
+			start = 0
+		}
+	}
+	if end > start+limit {
+		end = start + limit
+	}
+
+	page := items[start:end]
+	children := make([]interface{}, len(page))
+	for i, it := range page {
+		children[i] = thingOf(it.kind(), it.data(s))
+	}
+
+	var after, before string
+	if len(page) > 0 && end < len(items) {
+		after = page[len(page)-1].fullID()
+	}
+	if len(page) > 0 && start > 0 {
+		before = page[0].fullID()
+	}
+
+	return listingOf(children, after, before)
+}
+
+func thingOf(kind string, data map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"kind": kind,
+		"data": data,
+	}
+}
+
+func listingOf(children []interface{}, after, before string) map[string]interface{} {
+	if children == nil {
+		children = []interface{}{}
+	}
+	return map[string]interface{}{
+		"kind": "Listing",
+		"data": map[string]interface{}{
+			"dist":     len(children),
+			"children": children,
+			"after":    nullable(after),
+			"before":   nullable(before),
+		},
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, code int) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(map[string]interface{}{
+		"message": http.StatusText(code),
+		"error":   code,
+	})
+}
+
+// writeAPIError writes an error the way Reddit does for form submissions, i.e. with a 200 status code.
+func writeAPIError(w http.ResponseWriter, label, message, field string) {
+	writeJSON(w, map[string]interface{}{
+		"json": map[string]interface{}{
+			"errors": [][]string{{label, message, field}},
+		},
+	})
+}
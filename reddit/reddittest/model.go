@@ -0,0 +1,324 @@
+package reddittest
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	kindComment   = "t1"
+	kindPost      = "t3"
+	kindMessage   = "t4"
+	kindSubreddit = "t5"
+	kindModAction = "modaction"
+	kindMore      = "more"
+)
+
+// item is a thing that can appear in a listing.
+type item interface {
+	fullID() string
+	kind() string
+	data(s *Server) map[string]interface{}
+}
+
+type subreddit struct {
+	id         string
+	name       string
+	created    time.Time
+	moderators map[string]bool
+}
+
+func (sr *subreddit) fullID() string { return kindSubreddit + "_" + sr.id }
+func (sr *subreddit) kind() string   { return kindSubreddit }
+
+func (sr *subreddit) isModerator(username string) bool {
+	return sr.moderators[strings.ToLower(username)]
+}
+
+func (sr *subreddit) data(s *Server) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                    sr.id,
+		"name":                  sr.fullID(),
+		"created_utc":           unix(sr.created),
+		"url":                   fmt.Sprintf("/r/%s/", sr.name),
+		"display_name":          sr.name,
+		"display_name_prefixed": "r/" + sr.name,
+		"title":                 sr.name,
+		"public_description":    "",
+		"subreddit_type":        "public",
+		"subscribers":           0,
+		"over18":                false,
+		"user_is_moderator":     sr.isModerator(s.username),
+		"user_is_subscriber":    false,
+		"user_has_favorited":    false,
+	}
+}
+
+type post struct {
+	id        string
+	seq       int
+	subreddit *subreddit
+	author    string
+	created   time.Time
+	edited    time.Time
+
+	title  string
+	body   string
+	url    string
+	isSelf bool
+
+	score       int
+	numComments int
+
+	locked  bool
+	removed bool
+	spam    bool
+	deleted bool
+}
+
+func (p *post) fullID() string { return kindPost + "_" + p.id }
+func (p *post) kind() string   { return kindPost }
+
+func (p *post) permalink() string {
+	return fmt.Sprintf("/r/%s/comments/%s/%s/", p.subreddit.name, p.id, slug(p.title))
+}
+
+func (p *post) data(s *Server) map[string]interface{} {
+	author, body := p.author, p.body
+	if p.deleted {
+		author, body = "[deleted]", "[deleted]"
+	}
+
+	return map[string]interface{}{
+		"id":                      p.id,
+		"name":                    p.fullID(),
+		"created_utc":             unix(p.created),
+		"edited":                  edited(p.edited),
+		"permalink":               p.permalink(),
+		"url":                     p.url,
+		"title":                   p.title,
+		"selftext":                body,
+		"likes":                   s.likes(p.fullID()),
+		"score":                   p.score,
+		"upvote_ratio":            1,
+		"num_comments":            p.numComments,
+		"subreddit":               p.subreddit.name,
+		"subreddit_name_prefixed": "r/" + p.subreddit.name,
+		"subreddit_id":            p.subreddit.fullID(),
+		"author":                  author,
+		"author_fullname":         s.userFullID(p.author),
+		"spoiler":                 false,
+		"locked":                  p.locked,
+		"over_18":                 false,
+		"is_self":                 p.isSelf,
+		"saved":                   false,
+		"stickied":                false,
+	}
+}
+
+type comment struct {
+	id       string
+	seq      int
+	post     *post
+	parentID string
+	author   string
+	created  time.Time
+	edited   time.Time
+	body     string
+	score    int
+
+	replies []*comment
+
+	locked  bool
+	removed bool
+	spam    bool
+	deleted bool
+}
+
+func (c *comment) fullID() string { return kindComment + "_" + c.id }
+func (c *comment) kind() string   { return kindComment }
+
+func (c *comment) permalink() string {
+	return c.post.permalink() + c.id + "/"
+}
+
+// data returns the comment without its replies.
+func (c *comment) data(s *Server) map[string]interface{} {
+	author, body := c.author, c.body
+	if c.deleted {
+		author, body = "[deleted]", "[deleted]"
+	}
+
+	return map[string]interface{}{
+		"id":                      c.id,
+		"name":                    c.fullID(),
+		"created_utc":             unix(c.created),
+		"edited":                  edited(c.edited),
+		"parent_id":               c.parentID,
+		"permalink":               c.permalink(),
+		"body":                    body,
+		"author":                  author,
+		"author_fullname":         s.userFullID(c.author),
+		"subreddit":               c.post.subreddit.name,
+		"subreddit_name_prefixed": "r/" + c.post.subreddit.name,
+		"subreddit_id":            c.post.subreddit.fullID(),
+		"likes":                   s.likes(c.fullID()),
+		"score":                   c.score,
+		"controversiality":        0,
+		"link_id":                 c.post.fullID(),
+		"link_title":              c.post.title,
+		"link_permalink":          c.post.permalink(),
+		"link_author":             c.post.author,
+		"is_submitter":            c.author == c.post.author,
+		"score_hidden":            false,
+		"saved":                   false,
+		"stickied":                false,
+		"locked":                  c.locked,
+		"can_gild":                false,
+		"over_18":                 false,
+		"replies":                 "",
+	}
+}
+
+// Types of messages in the inbox.
+const (
+	messagePrivate      = "private"
+	messagePostReply    = "post_reply"
+	messageCommentReply = "comment_reply"
+	messageMention      = "username_mention"
+)
+
+// message is an entry of an inbox: either a private message, or a comment
+// that replies to or mentions the recipient.
+type message struct {
+	id      string
+	seq     int
+	typ     string
+	author  string
+	dest    string
+	created time.Time
+	subject string
+	body    string
+	unread  bool
+
+	// Set if the message is a comment.
+	comment *comment
+}
+
+func (m *message) fullID() string {
+	if m.comment != nil {
+		return m.comment.fullID()
+	}
+	return kindMessage + "_" + m.id
+}
+
+func (m *message) kind() string {
+	if m.comment != nil {
+		return kindComment
+	}
+	return kindMessage
+}
+
+func (m *message) data(s *Server) map[string]interface{} {
+	data := map[string]interface{}{
+		"id":          m.id,
+		"name":        m.fullID(),
+		"created_utc": unix(m.created),
+		"subject":     m.subject,
+		"body":        m.body,
+		"parent_id":   nil,
+		"author":      m.author,
+		"dest":        m.dest,
+		"was_comment": m.comment != nil,
+		"new":         m.unread,
+		"type":        m.typ,
+		"replies":     "",
+	}
+
+	if c := m.comment; c != nil {
+		data["id"] = c.id
+		data["parent_id"] = c.parentID
+		data["subreddit"] = c.post.subreddit.name
+		data["subreddit_name_prefixed"] = "r/" + c.post.subreddit.name
+		data["link_title"] = c.post.title
+		data["context"] = c.permalink() + "?context=3"
+	}
+
+	return data
+}
+
+type modAction struct {
+	id        string
+	seq       int
+	subreddit *subreddit
+	action    string
+	moderator string
+	created   time.Time
+	details   string
+
+	target item
+}
+
+func (a *modAction) fullID() string { return a.id }
+func (a *modAction) kind() string   { return kindModAction }
+
+func (a *modAction) data(s *Server) map[string]interface{} {
+	data := map[string]interface{}{
+		"id":                      a.id,
+		"action":                  a.action,
+		"created_utc":             unix(a.created),
+		"mod":                     a.moderator,
+		"mod_id36":                s.userID(a.moderator),
+		"details":                 nullable(a.details),
+		"subreddit":               a.subreddit.name,
+		"subreddit_name_prefixed": "r/" + a.subreddit.name,
+		"sr_id36":                 a.subreddit.id,
+		"target_fullname":         a.target.fullID(),
+	}
+
+	switch target := a.target.(type) {
+	case *post:
+		data["target_author"] = target.author
+		data["target_title"] = target.title
+		data["target_permalink"] = target.permalink()
+	case *comment:
+		data["target_author"] = target.author
+		data["target_body"] = target.body
+		data["target_permalink"] = target.permalink()
+	}
+
+	return data
+}
+
+func unix(t time.Time) float64 {
+	return float64(t.Unix())
+}
+
+func edited(t time.Time) interface{} {
+	if t.IsZero() {
+		return false
+	}
+	return unix(t)
+}
+
+func nullable(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+	return s
+}
+
+// slug returns the part of a post's permalink made from its title.
+func slug(title string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(title) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case b.Len() > 0 && !strings.HasSuffix(b.String(), "_"):
+			b.WriteByte('_')
+		}
+	}
+	return strings.TrimSuffix(b.String(), "_")
+}
@@ -0,0 +1,484 @@
+// Package reddittest provides a fake Reddit server for end to end tests of code built on the reddit package.
+//
+// The server keeps an in-memory model of subreddits, posts, comments, inboxes, and moderator actions,
+// which changes as requests are made to it, e.g. submitting a post adds it to the subreddit's listings,
+// and replying to a comment adds the reply to the inbox of the comment's author.
+//
+//	server, err := reddittest.NewServer()
+//	if err != nil {
+//		return err
+//	}
+//	defer server.Close()
+//
+//	server.AddSubreddit("golang", reddittest.Username)
+//	post, _, err := server.Client.Post.SubmitText(ctx, reddit.SubmitTextOptions{
+//		Subreddit: "golang",
+//		Title:     "Hello",
+//	})
+package reddittest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vartanbeno/go-reddit/reddit"
+)
+
+// Username is the name of the user the client of the server is authenticated as.
+const Username = "reddittest"
+
+// Access token handed out by the server.
+const accessToken = "reddittest-token"
+
+// Default number of replies to a post or comment returned before the rest are left out.
+const defaultReplyLimit = 200
+
+// Server is a fake Reddit server.
+// It is safe for concurrent use.
+type Server struct {
+	// Client makes requests to the server, authenticated as Username.
+	Client *reddit.Client
+
+	server   *httptest.Server
+	username string
+
+	mu sync.Mutex
+	// Last ID given to a thing.
+	lastID int64
+	// Sequence number of the last thing created, used to sort listings by newest.
+	lastSeq int
+
+	users      map[string]string
+	subreddits map[string]*subreddit
+	posts      map[string]*post
+	comments   map[string]*comment
+	messages   map[string]*message
+	modActions []*modAction
+	// Votes of the user, by full ID.
+	votes map[string]int
+
+	replyLimit int
+}
+
+// NewServer starts a fake Reddit server and returns it, along with a client configured
+// to make requests to it. The options are applied to the client after the ones that
+// point it to the server.
+func NewServer(opts ...reddit.Opt) (*Server, error) {
+	s := &Server{
+		username:   Username,
+		users:      make(map[string]string),
+		subreddits: make(map[string]*subreddit),
+		posts:      make(map[string]*post),
+		comments:   make(map[string]*comment),
+		messages:   make(map[string]*message),
+		votes:      make(map[string]int),
+		replyLimit: defaultReplyLimit,
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+
+	opts = append([]reddit.Opt{
+		reddit.WithBaseURL(s.server.URL),
+		reddit.WithTokenURL(s.server.URL + "/api/v1/access_token"),
+	}, opts...)
+
+	client, err := reddit.NewClient(nil, &reddit.Credentials{
+		ID:       "reddittest-id",
+		Secret:   "reddittest-secret",
+		Username: s.username,
+		Password: "reddittest-password",
+	}, opts...)
+	if err != nil {
+		s.server.Close()
+		return nil, err
+	}
+	s.Client = client
+
+	return s, nil
+}
+
+// URL returns the base URL of the server.
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// SetReplyLimit sets the number of replies to a post or comment that are returned
+// when getting a post, before the rest are left out in a "more" entry that can be
+// loaded via the api/morechildren endpoint. The default is 200.
+func (s *Server) SetReplyLimit(limit int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.replyLimit = limit
+}
+
+// AddSubreddit adds a subreddit to the server, moderated by the moderators.
+// If it already exists, the moderators are added to it.
+func (s *Server) AddSubreddit(name string, moderators ...string) *reddit.Subreddit {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sr := s.subreddit(name)
+	for _, moderator := range moderators {
+		sr.moderators[strings.ToLower(moderator)] = true
+	}
+
+	v := new(reddit.Subreddit)
+	s.decode(sr, v)
+	return v
+}
+
+// AddPost adds a text post to the subreddit, creating the subreddit if needed.
+func (s *Server) AddPost(subreddit, author, title, text string) *reddit.Post {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := s.newPost(s.subreddit(subreddit), author, title)
+	p.body = text
+	p.isSelf = true
+	p.url = "https://www.reddit.com" + p.permalink()
+
+	v := new(reddit.Post)
+	s.decode(p, v)
+	return v
+}
+
+// AddComment adds a comment replying to the post or comment with the full ID.
+// Replies and mentions of users are added to their inboxes.
+func (s *Server) AddComment(parentID, author, text string) (*reddit.Comment, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, err := s.newComment(parentID, author, text)
+	if err != nil {
+		return nil, err
+	}
+
+	v := new(reddit.Comment)
+	s.decode(c, v)
+	return v, nil
+}
+
+// AddMessage sends a private message to the user.
+func (s *Server) AddMessage(from, to, subject, text string) *reddit.Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	m := s.newMessage(messagePrivate, from, to, subject, text, nil)
+
+	v := new(reddit.Message)
+	s.decode(m, v)
+	return v
+}
+
+// Posts returns the posts of the subreddit, newest first, including the removed ones.
+func (s *Server) Posts(subreddit string) []*reddit.Post {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var posts []*reddit.Post
+	for _, p := range s.sortedPosts() {
+		if strings.EqualFold(p.subreddit.name, subreddit) {
+			v := new(reddit.Post)
+			s.decode(p, v)
+			posts = append(posts, v)
+		}
+	}
+	return posts
+}
+
+// Comments returns the comments of the post with the full ID, oldest first.
+func (s *Server) Comments(postID string) []*reddit.Comment {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var comments []*comment
+	for _, c := range s.comments {
+		if c.post.fullID() == postID {
+			comments = append(comments, c)
+		}
+	}
+	sort.Slice(comments, func(i, j int) bool {
+		return comments[i].seq < comments[j].seq
+	})
+
+	result := make([]*reddit.Comment, len(comments))
+	for i, c := range comments {
+		result[i] = new(reddit.Comment)
+		s.decode(c, result[i])
+	}
+	return result
+}
+
+// Inbox returns the messages in the inbox of the user, newest first.
+func (s *Server) Inbox(username string) []*reddit.Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var messages []*reddit.Message
+	for _, m := range s.inbox(username, nil) {
+		v := new(reddit.Message)
+		s.decode(m, v)
+		messages = append(messages, v)
+	}
+	return messages
+}
+
+// ModActions returns the moderator actions taken in the subreddit, newest first.
+func (s *Server) ModActions(subreddit string) []*reddit.ModAction {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var actions []*reddit.ModAction
+	for i := len(s.modActions) - 1; i >= 0; i-- {
+		a := s.modActions[i]
+		if strings.EqualFold(a.subreddit.name, subreddit) {
+			v := new(reddit.ModAction)
+			s.decode(a, v)
+			actions = append(actions, v)
+		}
+	}
+	return actions
+}
+
+// decode converts the item into its type in the reddit package, going through its JSON representation.
+func (s *Server) decode(it item, v interface{}) {
+	b, _ := json.Marshal(it.data(s))
+	json.Unmarshal(b, v)
+}
+
+// newID returns a new ID36.
+func (s *Server) newID() string {
+	s.lastID++
+	// start from an ID that looks like the ones on Reddit
+	return strconv.FormatInt(1e9+s.lastID, 36)
+}
+
+func (s *Server) nextSeq() int {
+	s.lastSeq++
+	return s.lastSeq
+}
+
+// userID returns the ID36 of the user, giving it one if needed.
+func (s *Server) userID(username string) string {
+	key := strings.ToLower(username)
+	id, ok := s.users[key]
+	if !ok {
+		id = s.newID()
+		s.users[key] = id
+	}
+	return id
+}
+
+func (s *Server) userFullID(username string) string {
+	return "t2_" + s.userID(username)
+}
+
+// likes returns the vote of the user on the thing, in the format of the "likes" field.
+func (s *Server) likes(fullID string) interface{} {
+	switch s.votes[fullID] {
+	case 1:
+		return true
+	case -1:
+		return false
+	default:
+		return nil
+	}
+}
+
+// subreddit returns the subreddit with the name, creating it if needed.
+func (s *Server) subreddit(name string) *subreddit {
+	key := strings.ToLower(name)
+	sr, ok := s.subreddits[key]
+	if !ok {
+		sr = &subreddit{
+			id:         s.newID(),
+			name:       name,
+			created:    time.Now(),
+			moderators: make(map[string]bool),
+		}
+		s.subreddits[key] = sr
+	}
+	return sr
+}
+
+func (s *Server) newPost(sr *subreddit, author, title string) *post {
+	p := &post{
+		id:        s.newID(),
+		seq:       s.nextSeq(),
+		subreddit: sr,
+		author:    author,
+		created:   time.Now(),
+		title:     title,
+		score:     1,
+	}
+	s.posts[p.id] = p
+	return p
+}
+
+func (s *Server) newComment(parentID, author, text string) (*comment, error) {
+	c := &comment{
+		id:       s.newID(),
+		seq:      s.nextSeq(),
+		parentID: parentID,
+		author:   author,
+		created:  time.Now(),
+		body:     text,
+		score:    1,
+	}
+
+	var recipient, subject, typ string
+	switch parent := s.thing(parentID).(type) {
+	case *post:
+		c.post = parent
+		recipient, subject, typ = parent.author, "post reply", messagePostReply
+	case *comment:
+		c.post = parent.post
+		parent.replies = append(parent.replies, c)
+		recipient, subject, typ = parent.author, "comment reply", messageCommentReply
+	default:
+		return nil, fmt.Errorf("parent %q: not found", parentID)
+	}
+
+	c.post.numComments++
+	s.comments[c.id] = c
+
+	if !strings.EqualFold(recipient, author) {
+		s.newMessage(typ, author, recipient, subject, text, c)
+	}
+	for _, mentioned := range mentions(text) {
+		if !strings.EqualFold(mentioned, author) && !strings.EqualFold(mentioned, recipient) {
+			s.newMessage(messageMention, author, mentioned, "username mention", text, c)
+		}
+	}
+
+	return c, nil
+}
+
+func (s *Server) newMessage(typ, from, to, subject, text string, c *comment) *message {
+	m := &message{
+		id:      s.newID(),
+		seq:     s.nextSeq(),
+		typ:     typ,
+		author:  from,
+		dest:    to,
+		created: time.Now(),
+		subject: subject,
+		body:    text,
+		unread:  true,
+		comment: c,
+	}
+	s.messages[m.id] = m
+	return m
+}
+
+// mentions returns the users mentioned in the text, e.g. u/name.
+func mentions(text string) []string {
+	var users []string
+	for _, word := range strings.Fields(text) {
+		word = strings.TrimPrefix(word, "/")
+		if !strings.HasPrefix(word, "u/") {
+			continue
+		}
+		name := strings.TrimRight(word[2:], ".,!?:;)")
+		if name != "" {
+			users = append(users, name)
+		}
+	}
+	return users
+}
+
+// thing returns the post, comment, message, or subreddit with the full ID, if it exists.
+func (s *Server) thing(fullID string) item {
+	parts := strings.SplitN(fullID, "_", 2)
+	if len(parts) != 2 {
+		return nil
+	}
+
+	switch kind, id := parts[0], parts[1]; kind {
+	case kindPost:
+		if p, ok := s.posts[id]; ok {
+			return p
+		}
+	case kindComment:
+		if c, ok := s.comments[id]; ok {
+			return c
+		}
+	case kindMessage:
+		for _, m := range s.messages {
+			if m.comment == nil && m.id == id {
+				return m
+			}
+		}
+	case kindSubreddit:
+		for _, sr := range s.subreddits {
+			if sr.id == id {
+				return sr
+			}
+		}
+	}
+
+	return nil
+}
+
+// sortedPosts returns all posts, newest first.
+func (s *Server) sortedPosts() []*post {
+	posts := make([]*post, 0, len(s.posts))
+	for _, p := range s.posts {
+		posts = append(posts, p)
+	}
+	sort.Slice(posts, func(i, j int) bool {
+		return posts[i].seq > posts[j].seq
+	})
+	return posts
+}
+
+// sortedComments returns all comments, newest first.
+func (s *Server) sortedComments() []*comment {
+	comments := make([]*comment, 0, len(s.comments))
+	for _, c := range s.comments {
+		comments = append(comments, c)
+	}
+	sort.Slice(comments, func(i, j int) bool {
+		return comments[i].seq > comments[j].seq
+	})
+	return comments
+}
+
+// inbox returns the messages of the user that match the filter (if any), newest first.
+func (s *Server) inbox(username string, filter func(m *message) bool) []*message {
+	var messages []*message
+	for _, m := range s.messages {
+		if strings.EqualFold(m.dest, username) && (filter == nil || filter(m)) {
+			messages = append(messages, m)
+		}
+	}
+	sort.Slice(messages, func(i, j int) bool {
+		return messages[i].seq > messages[j].seq
+	})
+	return messages
+}
+
+// addModAction records an action taken by the user on the target.
+func (s *Server) addModAction(sr *subreddit, action string, target item, details string) {
+	s.modActions = append(s.modActions, &modAction{
+		id:        "ModAction_" + s.newID(),
+		seq:       s.nextSeq(),
+		subreddit: sr,
+		action:    action,
+		moderator: s.username,
+		created:   time.Now(),
+		details:   details,
+		target:    target,
+	})
+}
@@ -0,0 +1,209 @@
+package reddittest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vartanbeno/go-reddit/reddit"
+)
+
+var ctx = context.Background()
+
+func setup(t *testing.T) *Server {
+	server, err := NewServer()
+	require.NoError(t, err)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestServer_SubmitAndList(t *testing.T) {
+	server := setup(t)
+	server.AddSubreddit("golang", Username)
+
+	submitted, _, err := server.Client.Post.SubmitText(ctx, reddit.SubmitTextOptions{
+		Subreddit: "golang",
+		Title:     "Hello World",
+		Text:      "Some text",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "t3_"+submitted.ID, submitted.FullID)
+
+	server.AddPost("golang", "gopher", "Second post", "")
+
+	posts, _, err := server.Client.Subreddit.NewPosts(ctx, "golang", nil)
+	require.NoError(t, err)
+	require.Len(t, posts.Posts, 2)
+	require.Equal(t, "Second post", posts.Posts[0].Title)
+	require.Equal(t, submitted.FullID, posts.Posts[1].FullID)
+	require.Equal(t, "Some text", posts.Posts[1].Body)
+	require.Equal(t, Username, posts.Posts[1].Author)
+	require.Equal(t, "/r/golang/comments/"+submitted.ID+"/hello_world/", posts.Posts[1].Permalink)
+
+	page, _, err := server.Client.Subreddit.NewPosts(ctx, "golang", &reddit.ListOptions{Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, page.Posts, 1)
+	require.Equal(t, posts.Posts[0].FullID, page.After)
+
+	page, _, err = server.Client.Subreddit.NewPosts(ctx, "golang", &reddit.ListOptions{Limit: 1, After: page.After})
+	require.NoError(t, err)
+	require.Len(t, page.Posts, 1)
+	require.Equal(t, submitted.FullID, page.Posts[0].FullID)
+	require.Empty(t, page.After)
+
+	_, _, err = server.Client.Post.SubmitText(ctx, reddit.SubmitTextOptions{Subreddit: "nope", Title: "title"})
+	var apiErr *reddit.APIError
+	require.True(t, errors.As(err, &apiErr))
+	require.Equal(t, "SUBREDDIT_NOEXIST", apiErr.Label)
+
+	_, _, err = server.Client.Subreddit.NewPosts(ctx, "nope", nil)
+	require.True(t, errors.Is(err, reddit.ErrNotFound))
+}
+
+func TestServer_Comments(t *testing.T) {
+	server := setup(t)
+	server.SetReplyLimit(2)
+
+	post := server.AddPost("golang", Username, "Post", "text")
+
+	comment, _, err := server.Client.Comment.Submit(ctx, post.FullID, "first")
+	require.NoError(t, err)
+	require.Equal(t, post.FullID, comment.ParentID)
+	require.Equal(t, post.FullID, comment.PostID)
+
+	for _, text := range []string{"second", "third"} {
+		_, err := server.AddComment(post.FullID, "gopher", text)
+		require.NoError(t, err)
+	}
+	reply, err := server.AddComment(comment.FullID, "gopher", "reply to u/someone")
+	require.NoError(t, err)
+
+	pc, _, err := server.Client.Post.Get(ctx, post.ID)
+	require.NoError(t, err)
+	require.Equal(t, 4, pc.Post.NumberOfComments)
+	require.Len(t, pc.Comments, 2)
+	require.Equal(t, "first", pc.Comments[0].Body)
+	require.Len(t, pc.Comments[0].Replies.Comments, 1)
+	require.Equal(t, reply.FullID, pc.Comments[0].Replies.Comments[0].FullID)
+	require.True(t, pc.HasMore())
+
+	_, err = server.Client.Post.LoadMoreComments(ctx, pc)
+	require.NoError(t, err)
+	require.Len(t, pc.Comments, 3)
+	require.Equal(t, "third", pc.Comments[2].Body)
+	require.False(t, pc.HasMore())
+
+	listed, _, _, _, err := server.Client.Listings.Get(ctx, reply.FullID, post.FullID)
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+
+	require.Len(t, server.Comments(post.FullID), 4)
+
+	// the user was mentioned, and gopher replied to the user's post and comment
+	require.Len(t, server.Inbox("someone"), 1)
+	require.Len(t, server.Inbox(Username), 3)
+}
+
+func TestServer_Inbox(t *testing.T) {
+	server := setup(t)
+
+	post := server.AddPost("golang", Username, "Post", "text")
+	_, err := server.AddComment(post.FullID, "gopher", "nice post")
+	require.NoError(t, err)
+	message := server.AddMessage("gopher", Username, "hi", "hello there")
+
+	comments, messages, _, err := server.Client.Message.InboxUnread(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, comments.Messages, 1)
+	require.True(t, comments.Messages[0].IsComment)
+	require.Equal(t, "nice post", comments.Messages[0].Text)
+	require.Len(t, messages.Messages, 1)
+	require.Equal(t, message.FullID, messages.Messages[0].FullID)
+
+	_, err = server.Client.Message.Read(ctx, message.FullID, comments.Messages[0].FullID)
+	require.NoError(t, err)
+
+	comments, messages, _, err = server.Client.Message.InboxUnread(ctx, nil)
+	require.NoError(t, err)
+	require.Empty(t, comments.Messages)
+	require.Empty(t, messages.Messages)
+
+	_, err = server.Client.Message.Send(ctx, &reddit.SendMessageRequest{To: "gopher", Subject: "re: hi", Text: "hey"})
+	require.NoError(t, err)
+	require.Len(t, server.Inbox("gopher"), 1)
+
+	sent, _, err := server.Client.Message.Sent(ctx, nil)
+	require.NoError(t, err)
+	require.Len(t, sent.Messages, 1)
+	require.Equal(t, "gopher", sent.Messages[0].To)
+}
+
+func TestServer_Moderation(t *testing.T) {
+	server := setup(t)
+	server.AddSubreddit("golang", Username)
+	server.AddSubreddit("rust")
+
+	post := server.AddPost("golang", "spammer", "Buy now", "")
+	other := server.AddPost("rust", "gopher", "Post", "")
+
+	_, err := server.Client.Moderation.RemoveSpam(ctx, post.FullID)
+	require.NoError(t, err)
+
+	posts, _, err := server.Client.Subreddit.NewPosts(ctx, "golang", nil)
+	require.NoError(t, err)
+	require.Empty(t, posts.Posts)
+
+	_, err = server.Client.Moderation.Remove(ctx, other.FullID)
+	require.True(t, errors.Is(err, reddit.ErrForbidden))
+
+	actions, _, err := server.Client.Moderation.GetActions(ctx, "golang", nil)
+	require.NoError(t, err)
+	require.Len(t, actions.ModActions, 1)
+	require.Equal(t, "spamlink", actions.ModActions[0].Action)
+	require.Equal(t, post.FullID, actions.ModActions[0].TargetID)
+	require.Equal(t, Username, actions.ModActions[0].Moderator)
+	require.Equal(t, server.ModActions("golang"), actions.ModActions)
+
+	_, err = server.Client.Moderation.Approve(ctx, post.FullID)
+	require.NoError(t, err)
+	require.Len(t, server.ModActions("golang"), 2)
+
+	posts, _, err = server.Client.Subreddit.NewPosts(ctx, "golang+rust", nil)
+	require.NoError(t, err)
+	require.Len(t, posts.Posts, 2)
+}
+
+func TestServer_Info(t *testing.T) {
+	server := setup(t)
+	server.AddSubreddit("golang")
+	server.AddSubreddit("rust")
+
+	_, _, err := server.Client.Post.SubmitLink(ctx, reddit.SubmitLinkOptions{Subreddit: "golang", Title: "Go", URL: "https://golang.org"})
+	require.NoError(t, err)
+	_, _, err = server.Client.Post.SubmitLink(ctx, reddit.SubmitLinkOptions{Subreddit: "rust", Title: "Go", URL: "https://golang.org"})
+	require.NoError(t, err)
+
+	posts, _, err := server.Client.Listings.GetPostsByURL(ctx, "https://golang.org", nil)
+	require.NoError(t, err)
+	require.Len(t, posts.Posts, 2)
+
+	subreddits, _, err := server.Client.Listings.GetSubredditsByName(ctx, "rust", "golang")
+	require.NoError(t, err)
+	require.Len(t, subreddits, 2)
+	require.Equal(t, "rust", subreddits[0].Name)
+
+	subreddit, _, err := server.Client.Subreddit.Get(ctx, "golang")
+	require.NoError(t, err)
+	require.Equal(t, "r/golang", subreddit.NamePrefixed)
+}
+
+func TestServer_SubmitNoURL(t *testing.T) {
+	server := setup(t)
+	server.AddSubreddit("golang")
+
+	_, _, err := server.Client.Post.SubmitLink(ctx, reddit.SubmitLinkOptions{Subreddit: "golang", Title: "Go"})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "NO_URL")
+	require.Empty(t, server.Posts("golang"))
+}
@@ -0,0 +1,104 @@
+package reddit
+
+import (
+	"errors"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultRetryMinBackoff = time.Second
+	defaultRetryMaxBackoff = time.Second * 30
+)
+
+// RetryPolicy configures how the client retries requests that failed because of
+// rate limiting (429) or a temporary server error (502, 503, 504).
+// Retries are delayed using exponential backoff with jitter. If Reddit tells us how long
+// to wait via the Retry-After header, that takes precedence.
+type RetryPolicy struct {
+	// Maximum number of times a request is retried. If 0 or less, requests are not retried.
+	MaxRetries int
+	// Delay before the first retry. It doubles with each subsequent retry.
+	// If 0 or less, it defaults to 1 second.
+	MinBackoff time.Duration
+	// Upper bound for the delay between 2 attempts.
+	// If 0 or less, it defaults to 30 seconds.
+	MaxBackoff time.Duration
+	// By default, only idempotent requests are retried (i.e. not POST and PATCH),
+	// since retrying them might perform the same action twice.
+	// Set this to true to retry them as well.
+	RetryNonIdempotent bool
+}
+
+// shouldRetry determines if the request should be retried, given the error
+// it failed with and how many attempts have been made so far.
+func (p *RetryPolicy) shouldRetry(req *http.Request, err error, attempt int) bool {
+	if p == nil || err == nil || attempt >= p.MaxRetries {
+		return false
+	}
+
+	if !p.RetryNonIdempotent && !isIdempotent(req.Method) {
+		return false
+	}
+
+	// the body has already been consumed by the first attempt and we have no way to get it back
+	if req.GetBody == nil && req.Body != nil && req.Body != http.NoBody {
+		return false
+	}
+
+	return isRetryable(err)
+}
+
+// backoff returns the delay before the next attempt.
+func (p *RetryPolicy) backoff(err error, attempt int) time.Duration {
+	var rateLimitErr *RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		if rateLimitErr.RetryAfter > 0 {
+			return rateLimitErr.RetryAfter
+		}
+	}
+
+	min, max := p.MinBackoff, p.MaxBackoff
+	if min <= 0 {
+		min = defaultRetryMinBackoff
+	}
+	if max <= 0 {
+		max = defaultRetryMaxBackoff
+	}
+
+	d := min << uint(attempt)
+	if d > max || d <= 0 {
+		d = max
+	}
+
+	// wait somewhere between half and all of the backoff
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(d-half)+1))
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPatch:
+		return false
+	default:
+		return true
+	}
+}
+
+func isRetryable(err error) bool {
+	var rateLimitErr *RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		return true
+	}
+
+	var errorResponse *ErrorResponse
+	if errors.As(err, &errorResponse) {
+		switch errorResponse.Response.StatusCode {
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,187 @@
+package reddit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var testRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	MinBackoff: time.Millisecond,
+	MaxBackoff: time.Millisecond * 5,
+}
+
+func TestClient_Retry(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	client.retryPolicy = &testRetryPolicy
+
+	var i int
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		defer func() { i++ }()
+
+		switch i {
+		case 0:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case 1:
+			w.WriteHeader(http.StatusTooManyRequests)
+		default:
+			fmt.Fprint(w, `{"ok": true}`)
+		}
+	})
+
+	req, err := client.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+
+	result := new(struct {
+		OK bool `json:"ok"`
+	})
+	resp, err := client.Do(ctx, req, result)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.True(t, result.OK)
+	require.Equal(t, 3, i)
+}
+
+func TestClient_Retry_MaxRetries(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	client.retryPolicy = &testRetryPolicy
+
+	var i int
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		i++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	req, err := client.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+
+	resp, err := client.Do(ctx, req, nil)
+	require.IsType(t, &ErrorResponse{}, err)
+	require.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	require.Equal(t, 4, i)
+}
+
+func TestClient_Retry_NotRetryable(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	client.retryPolicy = &testRetryPolicy
+
+	var i int
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		i++
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	req, err := client.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(ctx, req, nil)
+	require.IsType(t, &ErrorResponse{}, err)
+	require.Equal(t, 1, i)
+}
+
+func TestClient_Retry_NonIdempotent(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	client.retryPolicy = &testRetryPolicy
+
+	var i int
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		i++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	req, err := client.NewRequestWithForm(http.MethodPost, "api/v1/test", nil)
+	require.NoError(t, err)
+
+	_, err = client.Do(ctx, req, nil)
+	require.IsType(t, &ErrorResponse{}, err)
+	require.Equal(t, 1, i)
+}
+
+func TestClient_Retry_NonIdempotentAllowed(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	policy := testRetryPolicy
+	policy.RetryNonIdempotent = true
+	client.retryPolicy = &policy
+
+	var i int
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.NoError(t, r.ParseForm())
+		require.Equal(t, "value", r.Form.Get("key"))
+
+		i++
+		if i == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	})
+
+	req, err := client.NewRequestWithForm(http.MethodPost, "api/v1/test", map[string][]string{"key": {"value"}})
+	require.NoError(t, err)
+
+	_, err = client.Do(ctx, req, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, i)
+}
+
+func TestClient_Retry_ContextDeadline(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	client.retryPolicy = &testRetryPolicy
+
+	var i int
+	mux.HandleFunc("/api/v1/test", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		i++
+		w.Header().Set(headerRetryAfter, "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	req, err := client.NewRequest(http.MethodGet, "api/v1/test", nil)
+	require.NoError(t, err)
+
+	ctx2, cancel := context.WithTimeout(ctx, time.Second)
+	defer cancel()
+
+	// Reddit asks us to wait longer than the deadline allows, so it shouldn't wait at all
+	start := time.Now()
+	_, err = client.Do(ctx2, req, nil)
+	require.IsType(t, &RateLimitError{}, err)
+	require.Equal(t, 1, i)
+	require.Less(t, int64(time.Since(start)), int64(time.Second))
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := &RetryPolicy{
+		MinBackoff: time.Second,
+		MaxBackoff: time.Second * 10,
+	}
+
+	for attempt, max := range []time.Duration{time.Second, time.Second * 2, time.Second * 4, time.Second * 8, time.Second * 10, time.Second * 10} {
+		d := policy.backoff(nil, attempt)
+		require.GreaterOrEqual(t, int64(d), int64(max/2))
+		require.LessOrEqual(t, int64(d), int64(max))
+	}
+
+	d := policy.backoff(&RateLimitError{RetryAfter: time.Minute}, 0)
+	require.Equal(t, time.Minute, d)
+}
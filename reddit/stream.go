@@ -2,10 +2,14 @@ package reddit
 
 import (
 	"context"
-	"sync"
+	"fmt"
+	"net/http"
 	"time"
 )
 
+// Reddit doesn't list more than this number of items, even when paging through a listing.
+const maxListingItems = 1000
+
 // StreamService allows streaming new content from Reddit as it appears.
 type StreamService struct {
 	client *Client
@@ -15,101 +19,552 @@ type StreamService struct {
 // It returns 2 channels and a function:
 //   - a channel into which new posts will be sent
 //   - a channel into which any errors will be sent
-//   - a function that the client can call once to stop the streaming and close the channels
+//   - a function that the client can call to stop the streaming and close the channels
+// The streaming also stops when the context is done, including any request in flight.
 // Because of the 100 post limit imposed by Reddit when fetching posts, some high-traffic
 // streams might drop submissions between API requests, such as when streaming r/all.
-func (s *StreamService) Posts(subreddit string, opts ...StreamOpt) (<-chan *Post, <-chan error, func()) {
-	streamConfig := &streamConfig{
-		Interval:       defaultStreamInterval,
-		DiscardInitial: false,
-		MaxRequests:    0,
+func (s *StreamService) Posts(ctx context.Context, subreddit string, opts ...StreamOpt) (<-chan *Post, <-chan error, func()) {
+	posts := make(chan *Post)
+
+	fetch := func(ctx context.Context, after string) ([]streamItem, string, error) {
+		result, _, err := s.client.Subreddit.NewPosts(ctx, subreddit, &ListOptions{Limit: 100, After: after})
+		if err != nil {
+			return nil, "", err
+		}
+
+		items := make([]streamItem, len(result.Posts))
+		for i, post := range result.Posts {
+			post := post
+			items[i] = streamItem{id: post.FullID, created: timestampTime(post.Created), send: func(ctx context.Context) error {
+				select {
+				case posts <- post:
+					return nil
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}}
+		}
+		return items, result.After, nil
 	}
-	for _, opt := range opts {
-		opt(streamConfig)
+
+	errs, stop := s.stream(ctx, newStreamConfig(opts), listingPath(subreddit, "new"), fetch, func() { close(posts) })
+	return posts, errs, stop
+}
+
+// Comments streams comments from the specified subreddit.
+// To stream from multiple, separate the names with a plus (+), e.g. "golang+test".
+// To stream from all, just specify "all".
+// It returns 2 channels and a function:
+//   - a channel into which new comments will be sent
+//   - a channel into which any errors will be sent
+//   - a function that the client can call to stop the streaming and close the channels
+// The streaming also stops when the context is done, including any request in flight.
+// Because of the 100 comment limit imposed by Reddit when fetching comments, some high-traffic
+// streams might drop comments between API requests, such as when streaming r/all.
+func (s *StreamService) Comments(ctx context.Context, subreddit string, opts ...StreamOpt) (<-chan *Comment, <-chan error, func()) {
+	comments := make(chan *Comment)
+	path := fmt.Sprintf("r/%s/comments", subreddit)
+
+	fetch := func(ctx context.Context, after string) ([]streamItem, string, error) {
+		root, err := s.getListing(ctx, path, after)
+		if err != nil {
+			return nil, "", err
+		}
+		result := root.getComments()
+
+		items := make([]streamItem, len(result.Comments))
+		for i, comment := range result.Comments {
+			comment := comment
+			items[i] = streamItem{id: comment.FullID, created: timestampTime(comment.Created), send: func(ctx context.Context) error {
+				select {
+				case comments <- comment:
+					return nil
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}}
+		}
+		return items, result.After, nil
 	}
 
-	ticker := time.NewTicker(streamConfig.Interval)
-	posts := make(chan *Post)
+	errs, stop := s.stream(ctx, newStreamConfig(opts), path, fetch, func() { close(comments) })
+	return comments, errs, stop
+}
+
+// Inbox streams the comments and messages that appear in your inbox.
+// It returns 2 channels and a function:
+//   - a channel into which new comments and messages will be sent
+//   - a channel into which any errors will be sent
+//   - a function that the client can call to stop the streaming and close the channels
+// The streaming also stops when the context is done, including any request in flight.
+// With the StreamMarkRead option, they are marked as read once they've been received.
+func (s *StreamService) Inbox(ctx context.Context, opts ...StreamOpt) (<-chan *Message, <-chan error, func()) {
+	return s.inbox(ctx, "message/inbox", opts)
+}
+
+// InboxUnread streams the unread comments and messages that appear in your inbox.
+// See Inbox for more information.
+func (s *StreamService) InboxUnread(ctx context.Context, opts ...StreamOpt) (<-chan *Message, <-chan error, func()) {
+	return s.inbox(ctx, "message/unread", opts)
+}
+
+// Mentions streams the comments that mention your username.
+// See Inbox for more information.
+func (s *StreamService) Mentions(ctx context.Context, opts ...StreamOpt) (<-chan *Message, <-chan error, func()) {
+	return s.inbox(ctx, "message/mentions", opts)
+}
+
+// CommentReplies streams the replies to your comments.
+// See Inbox for more information.
+func (s *StreamService) CommentReplies(ctx context.Context, opts ...StreamOpt) (<-chan *Message, <-chan error, func()) {
+	return s.inbox(ctx, "message/comments", opts)
+}
+
+// PostReplies streams the replies to your posts.
+// See Inbox for more information.
+func (s *StreamService) PostReplies(ctx context.Context, opts ...StreamOpt) (<-chan *Message, <-chan error, func()) {
+	return s.inbox(ctx, "message/selfreply", opts)
+}
+
+// Messages streams the private messages that you receive.
+// See Inbox for more information.
+func (s *StreamService) Messages(ctx context.Context, opts ...StreamOpt) (<-chan *Message, <-chan error, func()) {
+	return s.inbox(ctx, "message/messages", opts)
+}
+
+func (s *StreamService) inbox(ctx context.Context, path string, opts []StreamOpt) (<-chan *Message, <-chan error, func()) {
+	streamConfig := newStreamConfig(opts)
+	// the inbox listings aren't part of MessageAPI, so if Message was replaced (e.g. by a mock),
+	// they are fetched with a MessageService of the client
+	messageService, ok := s.client.Message.(*MessageService)
+	if !ok {
+		messageService = &MessageService{client: s.client}
+	}
+	messages := make(chan *Message)
+
+	fetch := func(ctx context.Context, after string) ([]streamItem, string, error) {
+		root, _, err := messageService.inbox(ctx, path, &ListOptions{Limit: 100, After: after})
+		if err != nil {
+			return nil, "", err
+		}
+
+		items := make([]streamItem, len(root.Data.Things.All))
+		for i, message := range root.Data.Things.All {
+			message := message
+			items[i] = streamItem{id: message.FullID, created: timestampTime(message.Created), send: func(ctx context.Context) error {
+				select {
+				case messages <- message:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+
+				if streamConfig.MarkRead {
+					_, err := s.client.Message.Read(ctx, message.FullID)
+					return err
+				}
+				return nil
+			}}
+		}
+		return items, root.Data.After, nil
+	}
+
+	errs, stop := s.stream(ctx, streamConfig, path, fetch, func() { close(messages) })
+	return messages, errs, stop
+}
+
+// ModQueue streams the posts and comments of the specified subreddit's modqueue, i.e. the ones
+// that require a moderator's review. To stream from all the subreddits you moderate, specify "mod".
+// It returns 2 channels and a function:
+//   - a channel into which new posts and comments will be sent
+//   - a channel into which any errors will be sent
+//   - a function that the client can call to stop the streaming and close the channels
+// The streaming also stops when the context is done, including any request in flight.
+func (s *StreamService) ModQueue(ctx context.Context, subreddit string, opts ...StreamOpt) (<-chan *ModerationItem, <-chan error, func()) {
+	return s.moderation(ctx, subreddit, "modqueue", opts)
+}
+
+// Reports streams the reported posts and comments of the specified subreddit.
+// See ModQueue for more information.
+func (s *StreamService) Reports(ctx context.Context, subreddit string, opts ...StreamOpt) (<-chan *ModerationItem, <-chan error, func()) {
+	return s.moderation(ctx, subreddit, "reports", opts)
+}
+
+// Spam streams the posts and comments of the specified subreddit that were marked as spam.
+// See ModQueue for more information.
+func (s *StreamService) Spam(ctx context.Context, subreddit string, opts ...StreamOpt) (<-chan *ModerationItem, <-chan error, func()) {
+	return s.moderation(ctx, subreddit, "spam", opts)
+}
+
+// Edited streams the posts and comments of the specified subreddit as they get edited.
+// Items that are edited again after they've been streamed aren't streamed a second time,
+// as long as they're remembered (see StreamDedupCapacity). See ModQueue for more information.
+func (s *StreamService) Edited(ctx context.Context, subreddit string, opts ...StreamOpt) (<-chan *ModerationItem, <-chan error, func()) {
+	return s.moderation(ctx, subreddit, "edited", opts)
+}
+
+// Unmoderated streams the posts of the specified subreddit that haven't been reviewed by a moderator.
+// See ModQueue for more information.
+func (s *StreamService) Unmoderated(ctx context.Context, subreddit string, opts ...StreamOpt) (<-chan *ModerationItem, <-chan error, func()) {
+	return s.moderation(ctx, subreddit, "unmoderated", opts)
+}
+
+func (s *StreamService) moderation(ctx context.Context, subreddit, where string, opts []StreamOpt) (<-chan *ModerationItem, <-chan error, func()) {
+	items := make(chan *ModerationItem)
+	path := fmt.Sprintf("r/%s/about/%s", subreddit, where)
+
+	fetch := func(ctx context.Context, after string) ([]streamItem, string, error) {
+		root, err := s.getModerationListing(ctx, path, after)
+		if err != nil {
+			return nil, "", err
+		}
+
+		result := make([]streamItem, len(root.Data.Items))
+		for i, item := range root.Data.Items {
+			item := item
+			result[i] = streamItem{id: item.FullID(), send: func(ctx context.Context) error {
+				select {
+				case items <- item:
+					return nil
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}}
+		}
+		return result, root.Data.After, nil
+	}
+
+	// e.g. reported items move to the top of the reports when they're reported again
+	streamConfig := newStreamConfig(opts)
+	streamConfig.Unordered = true
+
+	errs, stop := s.stream(ctx, streamConfig, path, fetch, func() { close(items) })
+	return items, errs, stop
+}
+
+// ModActions streams the actions performed by the moderators of the specified subreddit,
+// from its moderation log. To stream from all the subreddits you moderate, specify "mod".
+// It returns 2 channels and a function:
+//   - a channel into which new actions will be sent
+//   - a channel into which any errors will be sent
+//   - a function that the client can call to stop the streaming and close the channels
+// The streaming also stops when the context is done, including any request in flight.
+func (s *StreamService) ModActions(ctx context.Context, subreddit string, opts ...StreamOpt) (<-chan *ModAction, <-chan error, func()) {
+	actions := make(chan *ModAction)
+
+	fetch := func(ctx context.Context, after string) ([]streamItem, string, error) {
+		result, _, err := s.client.Moderation.GetActions(ctx, subreddit, &ListModActionOptions{ListOptions: ListOptions{Limit: 100, After: after}})
+		if err != nil {
+			return nil, "", err
+		}
+
+		items := make([]streamItem, len(result.ModActions))
+		for i, action := range result.ModActions {
+			action := action
+			items[i] = streamItem{id: action.ID, created: timestampTime(action.Created), send: func(ctx context.Context) error {
+				select {
+				case actions <- action:
+					return nil
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}}
+		}
+		return items, result.After, nil
+	}
+
+	errs, stop := s.stream(ctx, newStreamConfig(opts), fmt.Sprintf("r/%s/about/log", subreddit), fetch, func() { close(actions) })
+	return actions, errs, stop
+}
+
+// streamItem is an item of a stream's listing.
+type streamItem struct {
+	id string
+	// Time the item was created, or the zero time if it's unknown.
+	created time.Time
+	// Sends the item to the stream's channel. It must give up once the context is done.
+	send func(ctx context.Context) error
+}
+
+// streamFetcher returns the items of a stream's listing, newest first, starting after the item
+// with the full ID after (if set). It also returns the anchor of the next page of the listing.
+type streamFetcher func(ctx context.Context, after string) ([]streamItem, string, error)
+
+// stream polls Reddit at the interval of the stream's config, until the context is done or the
+// returned stop function is called. Every time, it fetches the latest items and sends the ones
+// that haven't been streamed yet. Errors returned when sending an item are sent to the error channel.
+// The name of the stream is the default name of its checkpoint. closeItems closes the channel of
+// the items once the stream has stopped. It returns the channel of the stream's errors, and the
+// stop function, which returns once the channels are closed.
+func (s *StreamService) stream(ctx context.Context, streamConfig *streamConfig, name string, fetch streamFetcher, closeItems func()) (<-chan error, func()) {
+	ctx, cancel := context.WithCancel(ctx)
 	errs := make(chan error)
+	done := make(chan struct{})
 
-	var once sync.Once
 	stop := func() {
-		once.Do(func() {
-			ticker.Stop()
-			close(posts)
-			close(errs)
-		})
+		cancel()
+		<-done
 	}
 
-	// originally used the "before" parameter, but if that post gets deleted, subsequent requests
-	// would just return empty listings; easier to just keep track of all post ids encountered
-	ids := set{}
+	// originally used the "before" parameter, but if that item gets deleted, subsequent requests
+	// would just return empty listings; easier to just keep track of the item ids encountered
+	ids := newRecentIDs(streamConfig.DedupCapacity)
+	discardInitial := streamConfig.DiscardInitial
+
+	// the newest item of unsorted listings isn't the last one that appeared
+	checkpointer := streamConfig.Checkpointer
+	if streamConfig.Unordered {
+		checkpointer = nil
+	}
+	if streamConfig.CheckpointName != "" {
+		name = streamConfig.CheckpointName
+	}
 
 	go func() {
-		defer stop()
+		defer func() {
+			cancel()
+			closeItems()
+			close(errs)
+			close(done)
+		}()
+
+		// report sends the error to the error channel, unless the stream stops first
+		report := func(err error) bool {
+			select {
+			case errs <- err:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
 
-		var n int
-		infinite := streamConfig.MaxRequests == 0
+		var checkpoint, saved Checkpoint
+		if checkpointer != nil {
+			var err error
+			checkpoint, err = checkpointer.LoadCheckpoint(name)
+			if err != nil && !report(err) {
+				return
+			}
+			saved = checkpoint
+		}
 
-		for ; ; <-ticker.C {
-			n++
+		ticker := time.NewTicker(streamConfig.Interval)
+		defer ticker.Stop()
 
-			result, err := s.getPosts(subreddit)
-			if err != nil {
-				errs <- err
-				if !infinite && n >= streamConfig.MaxRequests {
-					break
+		for n := 1; ; n++ {
+			var result []streamItem
+			var err error
+			if checkpoint.ID != "" {
+				var resumed int
+				result, resumed, err = backfill(ctx, fetch, checkpoint)
+				if err == nil {
+					// the items from the checkpoint on were streamed before the stream was restarted
+					for _, item := range result[resumed:] {
+						ids.Add(item.id)
+					}
+					result = result[:resumed]
+					checkpoint = Checkpoint{}
+					discardInitial = false
 				}
-				continue
+			} else {
+				result, _, err = fetch(ctx, "")
+			}
+			if ctx.Err() != nil {
+				return
 			}
 
-			for _, post := range result.Posts {
-				id := post.FullID
+			if err != nil && !report(err) {
+				return
+			}
 
-				// if this post id is already part of the set, it means that it and the ones
+			for _, item := range result {
+				// if this id is already part of the set, it means that it and the ones
 				// after it in the list have already been streamed, so break out of the loop
-				if ids.Exists(id) {
+				// (unless the list isn't sorted by the time the items appeared)
+				if ids.Exists(item.id) {
+					if streamConfig.Unordered {
+						continue
+					}
 					break
 				}
-				ids.Add(id)
+				ids.Add(item.id)
 
-				if streamConfig.DiscardInitial {
-					streamConfig.DiscardInitial = false
+				if discardInitial {
+					// all the ids of an unsorted list have to be remembered to discard them
+					if streamConfig.Unordered {
+						continue
+					}
+					discardInitial = false
 					break
 				}
 
-				posts <- post
+				if err := item.send(ctx); err != nil {
+					if ctx.Err() != nil || !report(err) {
+						return
+					}
+				}
+			}
+			if streamConfig.Unordered && err == nil {
+				discardInitial = false
+			}
+
+			// the checkpoint is only moved once all the new items have been received
+			if checkpointer != nil && len(result) > 0 && result[0].id != saved.ID {
+				newest := Checkpoint{ID: result[0].id, Created: result[0].created}
+				if err := checkpointer.SaveCheckpoint(name, newest); err != nil {
+					if !report(err) {
+						return
+					}
+				} else {
+					saved = newest
+				}
+			}
+
+			if streamConfig.MaxRequests > 0 && n >= streamConfig.MaxRequests {
+				return
 			}
 
-			if !infinite && n >= streamConfig.MaxRequests {
-				break
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
 			}
 		}
 	}()
 
-	return posts, errs, stop
+	return errs, stop
 }
 
-func (s *StreamService) getPosts(subreddit string) (*Posts, error) {
-	result, _, err := s.client.Subreddit.NewPosts(context.Background(), subreddit, &ListOptions{Limit: 100})
-	return result, err
+// backfill fetches the stream's listing page by page, until it reaches the item of the checkpoint
+// or Reddit's listing limit. If the item is no longer listed, e.g. because it was removed, it stops
+// at the first item that isn't newer than it instead. It returns the items of the fetched pages,
+// newest first, along with the index of the item it stopped at, or the number of items if there isn't one.
+func backfill(ctx context.Context, fetch streamFetcher, checkpoint Checkpoint) ([]streamItem, int, error) {
+	var items []streamItem
+	var after string
+
+	for len(items) < maxListingItems {
+		page, next, err := fetch(ctx, after)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		for i, item := range page {
+			if item.id == checkpoint.ID || notNewer(item, checkpoint) {
+				return append(items, page...), len(items) + i, nil
+			}
+		}
+		items = append(items, page...)
+
+		if next == "" || len(page) == 0 {
+			break
+		}
+		after = next
+	}
+
+	return items, len(items), nil
 }
 
-type set map[string]struct{}
+// notNewer reports whether the item was created no later than the item of the checkpoint.
+// It is false if either creation time is unknown.
+func notNewer(item streamItem, checkpoint Checkpoint) bool {
+	if item.created.IsZero() || checkpoint.Created.IsZero() {
+		return false
+	}
+	return !item.created.After(checkpoint.Created)
+}
+
+// timestampTime returns the time of the timestamp, or the zero time if it's nil.
+func timestampTime(t *Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.Time
+}
 
-func (s set) Add(v string) {
-	s[v] = struct{}{}
+// listingPath returns the path of the listing of the subreddit with the sort,
+// or of the front page if the subreddit is empty.
+func listingPath(subreddit, sort string) string {
+	if subreddit == "" {
+		return sort
+	}
+	return fmt.Sprintf("r/%s/%s", subreddit, sort)
 }
 
-func (s set) Delete(v string) {
-	delete(s, v)
+func (s *StreamService) getListing(ctx context.Context, path, after string) (*rootListing, error) {
+	path, err := addOptions(path, &ListOptions{Limit: 100, After: after})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	root := new(rootListing)
+	_, err = s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, err
+	}
+
+	return root, nil
+}
+
+func (s *StreamService) getModerationListing(ctx context.Context, path, after string) (*rootModerationListing, error) {
+	path, err := addOptions(path, &ListOptions{Limit: 100, After: after})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := s.client.NewRequest(http.MethodGet, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	root := new(rootModerationListing)
+	_, err = s.client.Do(ctx, req, root)
+	if err != nil {
+		return nil, err
+	}
+
+	return root, nil
+}
+
+// recentIDs is a set of the most recently added IDs, with a fixed capacity.
+// Once it's full, adding an ID evicts the oldest one.
+type recentIDs struct {
+	ring  []string
+	next  int
+	index map[string]struct{}
+}
+
+func newRecentIDs(capacity int) *recentIDs {
+	return &recentIDs{
+		ring:  make([]string, 0, capacity),
+		index: make(map[string]struct{}, capacity),
+	}
+}
+
+func (r *recentIDs) Add(id string) {
+	if r.Exists(id) {
+		return
+	}
+
+	if len(r.ring) < cap(r.ring) {
+		r.ring = append(r.ring, id)
+	} else {
+		delete(r.index, r.ring[r.next])
+		r.ring[r.next] = id
+		r.next = (r.next + 1) % len(r.ring)
+	}
+	r.index[id] = struct{}{}
 }
 
-func (s set) Len() int {
-	return len(s)
+func (r *recentIDs) Len() int {
+	return len(r.ring)
 }
 
-func (s set) Exists(v string) bool {
-	_, ok := s[v]
+func (r *recentIDs) Exists(id string) bool {
+	_, ok := r.index[id]
 	return ok
 }
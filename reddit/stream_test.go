@@ -1,6 +1,7 @@
 package reddit
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"testing"
@@ -135,7 +136,7 @@ func TestStreamService_Posts(t *testing.T) {
 		}
 	})
 
-	posts, errs, stop := client.Stream.Posts("testsubreddit", StreamInterval(time.Millisecond*10), StreamMaxRequests(4))
+	posts, errs, stop := client.Stream.Posts(ctx, "testsubreddit", StreamInterval(time.Millisecond*10), StreamMaxRequests(4))
 	defer stop()
 
 	expectedPostIDs := []string{"t3_post1", "t3_post2", "t3_post3", "t3_post4", "t3_post5", "t3_post6", "t3_post7", "t3_post8", "t3_post9", "t3_post10", "t3_post11", "t3_post12"}
@@ -287,7 +288,7 @@ func TestStreamService_Posts_DiscardInitial(t *testing.T) {
 		}
 	})
 
-	posts, errs, stop := client.Stream.Posts("testsubreddit", StreamInterval(time.Millisecond*10), StreamMaxRequests(4), StreamDiscardInitial)
+	posts, errs, stop := client.Stream.Posts(ctx, "testsubreddit", StreamInterval(time.Millisecond*10), StreamMaxRequests(4), StreamDiscardInitial)
 	defer stop()
 
 	expectedPostIDs := []string{"t3_post3", "t3_post4", "t3_post5", "t3_post6", "t3_post7", "t3_post8", "t3_post9", "t3_post10", "t3_post11", "t3_post12"}
@@ -312,3 +313,582 @@ loop:
 
 	require.Len(t, expectedPostIDs, i)
 }
+
+func TestStreamService_Comments(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var counter int
+
+	mux.HandleFunc("/r/test1+test2/comments", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "100", r.URL.Query().Get("limit"))
+		defer func() { counter++ }()
+
+		switch counter {
+		case 0:
+			fmt.Fprint(w, `{
+				"kind": "Listing",
+				"data": {
+					"children": [
+						{"kind": "t1", "data": {"name": "t1_comment2", "subreddit": "test2"}},
+						{"kind": "t1", "data": {"name": "t1_comment1", "subreddit": "test1"}}
+					]
+				}
+			}`)
+		case 1:
+			fmt.Fprint(w, `{
+				"kind": "Listing",
+				"data": {
+					"children": [
+						{"kind": "t1", "data": {"name": "t1_comment4", "subreddit": "test1"}},
+						{"kind": "t1", "data": {"name": "t1_comment3", "subreddit": "test2"}},
+						{"kind": "t1", "data": {"name": "t1_comment2", "subreddit": "test2"}}
+					]
+				}
+			}`)
+		default:
+			fmt.Fprint(w, `{}`)
+		}
+	})
+
+	comments, errs, stop := client.Stream.Comments(ctx, "test1+test2", StreamInterval(time.Millisecond*10), StreamMaxRequests(3))
+	defer stop()
+
+	expectedCommentIDs := []string{"t1_comment2", "t1_comment1", "t1_comment4", "t1_comment3"}
+	var i int
+
+loop:
+	for i != len(expectedCommentIDs) {
+		select {
+		case comment, ok := <-comments:
+			if !ok {
+				break loop
+			}
+			require.Equal(t, expectedCommentIDs[i], comment.FullID)
+		case err, ok := <-errs:
+			if !ok {
+				break loop
+			}
+			require.NoError(t, err)
+		}
+		i++
+	}
+
+	require.Len(t, expectedCommentIDs, i)
+}
+
+func TestStreamService_Posts_Context(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	requested := make(chan struct{})
+	cancelled := make(chan struct{})
+	mux.HandleFunc("/r/testsubreddit/new", func(w http.ResponseWriter, r *http.Request) {
+		close(requested)
+		// block until the request is cancelled
+		<-r.Context().Done()
+		close(cancelled)
+	})
+
+	ctx, cancel := context.WithCancel(ctx)
+	posts, errs, stop := client.Stream.Posts(ctx, "testsubreddit", StreamInterval(time.Millisecond*10))
+	defer stop()
+
+	<-requested
+	cancel()
+
+	_, ok := <-posts
+	require.False(t, ok)
+	_, ok = <-errs
+	require.False(t, ok)
+
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("in-flight request wasn't cancelled")
+	}
+}
+
+func TestStreamService_Posts_Stop(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/r/testsubreddit/new", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"kind": "Listing", "data": {"children": [{"kind": "t3", "data": {"name": "t3_post1"}}]}}`)
+	})
+
+	posts, errs, stop := client.Stream.Posts(ctx, "testsubreddit", StreamInterval(time.Millisecond*10))
+
+	post := <-posts
+	require.Equal(t, "t3_post1", post.FullID)
+
+	// the stream is blocked on neither channel while stopping
+	stop()
+	stop()
+
+	_, ok := <-posts
+	require.False(t, ok)
+	_, ok = <-errs
+	require.False(t, ok)
+}
+
+func TestRecentIDs(t *testing.T) {
+	ids := newRecentIDs(3)
+
+	ids.Add("t3_1")
+	ids.Add("t3_2")
+	ids.Add("t3_2")
+	ids.Add("t3_3")
+	require.Equal(t, 3, ids.Len())
+	require.True(t, ids.Exists("t3_1"))
+
+	// evicts the oldest
+	ids.Add("t3_4")
+	require.Equal(t, 3, ids.Len())
+	require.False(t, ids.Exists("t3_1"))
+	require.True(t, ids.Exists("t3_2"))
+	require.True(t, ids.Exists("t3_4"))
+
+	ids.Add("t3_5")
+	ids.Add("t3_6")
+	require.False(t, ids.Exists("t3_2"))
+	require.False(t, ids.Exists("t3_3"))
+	require.True(t, ids.Exists("t3_4"))
+	require.True(t, ids.Exists("t3_5"))
+	require.True(t, ids.Exists("t3_6"))
+}
+
+func TestStreamService_InboxUnread(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var counter int
+	mux.HandleFunc("/message/unread", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		defer func() { counter++ }()
+
+		switch counter {
+		case 0:
+			fmt.Fprint(w, `{
+				"kind": "Listing",
+				"data": {
+					"children": [
+						{"kind": "t1", "data": {"name": "t1_reply", "was_comment": true}},
+						{"kind": "t4", "data": {"name": "t4_message1"}}
+					]
+				}
+			}`)
+		default:
+			fmt.Fprint(w, `{
+				"kind": "Listing",
+				"data": {
+					"children": [
+						{"kind": "t4", "data": {"name": "t4_message2"}}
+					]
+				}
+			}`)
+		}
+	})
+
+	var read []string
+	mux.HandleFunc("/api/read_message", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.NoError(t, r.ParseForm())
+		read = append(read, r.Form.Get("id"))
+	})
+
+	messages, errs, stop := client.Stream.InboxUnread(ctx, StreamInterval(time.Millisecond*10), StreamMaxRequests(2), StreamMarkRead)
+	defer stop()
+
+	var ids []string
+loop:
+	for {
+		select {
+		case message, ok := <-messages:
+			if !ok {
+				break loop
+			}
+			ids = append(ids, message.FullID)
+		case err, ok := <-errs:
+			if !ok {
+				break loop
+			}
+			require.NoError(t, err)
+		}
+	}
+
+	require.Equal(t, []string{"t1_reply", "t4_message1", "t4_message2"}, ids)
+	require.Equal(t, []string{"t1_reply", "t4_message1", "t4_message2"}, read)
+}
+
+func TestStreamService_Mentions(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/message/mentions", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "100", r.URL.Query().Get("limit"))
+		fmt.Fprint(w, `{
+			"kind": "Listing",
+			"data": {
+				"children": [
+					{"kind": "t1", "data": {"name": "t1_mention", "was_comment": true}}
+				]
+			}
+		}`)
+	})
+	mux.HandleFunc("/api/read_message", func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("message was marked as read")
+	})
+
+	messages, _, stop := client.Stream.Mentions(ctx, StreamInterval(time.Millisecond*10), StreamMaxRequests(1))
+	defer stop()
+
+	message := <-messages
+	require.Equal(t, "t1_mention", message.FullID)
+	require.True(t, message.IsComment)
+}
+
+// readRecorder is a MessageAPI that records the messages marked as read instead of sending requests.
+type readRecorder struct {
+	MessageAPI
+	read []string
+}
+
+func (r *readRecorder) Read(ctx context.Context, ids ...string) (*Response, error) {
+	r.read = append(r.read, ids...)
+	return nil, nil
+}
+
+func TestStreamService_Inbox_MessageAPI(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/message/unread", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+			"kind": "Listing",
+			"data": {
+				"children": [
+					{"kind": "t4", "data": {"name": "t4_message1"}}
+				]
+			}
+		}`)
+	})
+	mux.HandleFunc("/api/read_message", func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("message was marked as read without going through client.Message")
+	})
+
+	recorder := &readRecorder{MessageAPI: client.Message}
+	client.Message = recorder
+
+	messages, errs, stop := client.Stream.InboxUnread(ctx, StreamInterval(time.Millisecond*10), StreamMaxRequests(1), StreamMarkRead)
+	defer stop()
+
+	message := <-messages
+	require.Equal(t, "t4_message1", message.FullID)
+
+	_, ok := <-errs
+	require.False(t, ok)
+	require.Equal(t, []string{"t4_message1"}, recorder.read)
+}
+
+func TestStreamService_Reports(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var counter int
+	mux.HandleFunc("/r/testsubreddit/about/reports", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "100", r.URL.Query().Get("limit"))
+		defer func() { counter++ }()
+
+		switch counter {
+		case 0:
+			fmt.Fprint(w, `{
+				"kind": "Listing",
+				"data": {
+					"children": [
+						{"kind": "t1", "data": {"name": "t1_comment1"}},
+						{"kind": "t3", "data": {"name": "t3_post1"}}
+					]
+				}
+			}`)
+		case 1:
+			// the post was reported again, so it moved to the top
+			fmt.Fprint(w, `{
+				"kind": "Listing",
+				"data": {
+					"children": [
+						{"kind": "t3", "data": {"name": "t3_post1"}},
+						{"kind": "t3", "data": {"name": "t3_post2"}},
+						{"kind": "t1", "data": {"name": "t1_comment1"}}
+					]
+				}
+			}`)
+		default:
+			fmt.Fprint(w, `{
+				"kind": "Listing",
+				"data": {
+					"children": [
+						{"kind": "t1", "data": {"name": "t1_comment2"}},
+						{"kind": "t3", "data": {"name": "t3_post2"}}
+					]
+				}
+			}`)
+		}
+	})
+
+	items, errs, stop := client.Stream.Reports(ctx, "testsubreddit", StreamInterval(time.Millisecond*10), StreamMaxRequests(3), StreamDiscardInitial)
+	defer stop()
+
+	var ids []string
+loop:
+	for {
+		select {
+		case item, ok := <-items:
+			if !ok {
+				break loop
+			}
+			if item.Post != nil {
+				require.Nil(t, item.Comment)
+			} else {
+				require.NotNil(t, item.Comment)
+			}
+			ids = append(ids, item.FullID())
+		case err, ok := <-errs:
+			if !ok {
+				break loop
+			}
+			require.NoError(t, err)
+		}
+	}
+
+	require.Equal(t, []string{"t3_post2", "t1_comment2"}, ids)
+}
+
+func TestStreamService_ModActions(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	blob, err := readFileContents("../testdata/moderation/actions.json")
+	require.NoError(t, err)
+
+	mux.HandleFunc("/r/testsubreddit/about/log", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "100", r.URL.Query().Get("limit"))
+		fmt.Fprint(w, blob)
+	})
+
+	actions, errs, stop := client.Stream.ModActions(ctx, "testsubreddit", StreamInterval(time.Millisecond*10), StreamMaxRequests(2))
+	defer stop()
+
+	var streamed []*ModAction
+loop:
+	for {
+		select {
+		case action, ok := <-actions:
+			if !ok {
+				break loop
+			}
+			streamed = append(streamed, action)
+		case err, ok := <-errs:
+			if !ok {
+				break loop
+			}
+			require.NoError(t, err)
+		}
+	}
+
+	require.Equal(t, expectedModActions.ModActions, streamed)
+}
+
+func TestStreamService_Posts_Checkpoint(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	var polls int
+	mux.HandleFunc("/r/testsubreddit/new", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "100", r.URL.Query().Get("limit"))
+
+		switch after := r.URL.Query().Get("after"); after {
+		case "":
+			polls++
+			if polls == 1 {
+				fmt.Fprint(w, `{
+					"kind": "Listing",
+					"data": {
+						"children": [
+							{"kind": "t3", "data": {"name": "t3_post6"}},
+							{"kind": "t3", "data": {"name": "t3_post5"}},
+							{"kind": "t3", "data": {"name": "t3_post4"}}
+						],
+						"after": "t3_post4"
+					}
+				}`)
+				return
+			}
+			fmt.Fprint(w, `{
+				"kind": "Listing",
+				"data": {
+					"children": [
+						{"kind": "t3", "data": {"name": "t3_post7"}},
+						{"kind": "t3", "data": {"name": "t3_post6"}},
+						{"kind": "t3", "data": {"name": "t3_post5"}}
+					],
+					"after": "t3_post5"
+				}
+			}`)
+		case "t3_post4":
+			// the checkpoint is on the second page
+			fmt.Fprint(w, `{
+				"kind": "Listing",
+				"data": {
+					"children": [
+						{"kind": "t3", "data": {"name": "t3_post3"}},
+						{"kind": "t3", "data": {"name": "t3_post2"}}
+					],
+					"after": "t3_post2"
+				}
+			}`)
+		default:
+			t.Fatalf("unexpected after: %s", after)
+		}
+	})
+
+	checkpointer := new(MemoryCheckpointer)
+	require.NoError(t, checkpointer.SaveCheckpoint("r/testsubreddit/new", Checkpoint{ID: "t3_post3"}))
+
+	posts, errs, stop := client.Stream.Posts(ctx, "testsubreddit",
+		StreamInterval(time.Millisecond*10),
+		StreamMaxRequests(2),
+		StreamCheckpoint(checkpointer, ""),
+		// ignored since there's a checkpoint
+		StreamDiscardInitial,
+	)
+	defer stop()
+
+	var ids []string
+loop:
+	for {
+		select {
+		case post, ok := <-posts:
+			if !ok {
+				break loop
+			}
+			ids = append(ids, post.FullID)
+		case err, ok := <-errs:
+			if !ok {
+				break loop
+			}
+			require.NoError(t, err)
+		}
+	}
+
+	require.Equal(t, []string{"t3_post6", "t3_post5", "t3_post4", "t3_post7"}, ids)
+
+	checkpoint, err := checkpointer.LoadCheckpoint("r/testsubreddit/new")
+	require.NoError(t, err)
+	require.Equal(t, Checkpoint{ID: "t3_post7"}, checkpoint)
+}
+
+func TestStreamService_Posts_CheckpointRemoved(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	// the post of the checkpoint (t3_post3, created at 1596283200) was removed
+	mux.HandleFunc("/r/testsubreddit/new", func(w http.ResponseWriter, r *http.Request) {
+		require.Empty(t, r.URL.Query().Get("after"))
+		fmt.Fprint(w, `{
+			"kind": "Listing",
+			"data": {
+				"children": [
+					{"kind": "t3", "data": {"name": "t3_post4", "created_utc": 1596283260}},
+					{"kind": "t3", "data": {"name": "t3_post2", "created_utc": 1596283140}},
+					{"kind": "t3", "data": {"name": "t3_post1", "created_utc": 1596283080}}
+				],
+				"after": "t3_post1"
+			}
+		}`)
+	})
+
+	checkpointer := new(MemoryCheckpointer)
+	require.NoError(t, checkpointer.SaveCheckpoint("r/testsubreddit/new", Checkpoint{
+		ID:      "t3_post3",
+		Created: time.Unix(1596283200, 0).UTC(),
+	}))
+
+	posts, errs, stop := client.Stream.Posts(ctx, "testsubreddit",
+		StreamInterval(time.Millisecond*10),
+		StreamMaxRequests(1),
+		StreamCheckpoint(checkpointer, ""),
+	)
+	defer stop()
+
+	var ids []string
+loop:
+	for {
+		select {
+		case post, ok := <-posts:
+			if !ok {
+				break loop
+			}
+			ids = append(ids, post.FullID)
+		case err, ok := <-errs:
+			if !ok {
+				break loop
+			}
+			require.NoError(t, err)
+		}
+	}
+
+	// the posts created before the checkpoint's were streamed before it
+	require.Equal(t, []string{"t3_post4"}, ids)
+
+	checkpoint, err := checkpointer.LoadCheckpoint("r/testsubreddit/new")
+	require.NoError(t, err)
+	require.Equal(t, Checkpoint{ID: "t3_post4", Created: time.Unix(1596283260, 0).UTC()}, checkpoint)
+}
+
+func TestStreamService_Posts_CheckpointName(t *testing.T) {
+	client, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/r/testsubreddit/new", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{
+			"kind": "Listing",
+			"data": {
+				"children": [
+					{"kind": "t3", "data": {"name": "t3_post2"}},
+					{"kind": "t3", "data": {"name": "t3_post1"}}
+				]
+			}
+		}`)
+	})
+
+	checkpointer := new(MemoryCheckpointer)
+
+	posts, errs, stop := client.Stream.Posts(ctx, "testsubreddit",
+		StreamInterval(time.Millisecond*10),
+		StreamMaxRequests(1),
+		StreamCheckpoint(checkpointer, "bot"),
+		StreamDiscardInitial,
+	)
+	defer stop()
+
+	_, ok := <-posts
+	require.False(t, ok)
+	_, ok = <-errs
+	require.False(t, ok)
+
+	// discarded items count as streamed
+	checkpoint, err := checkpointer.LoadCheckpoint("bot")
+	require.NoError(t, err)
+	require.Equal(t, Checkpoint{ID: "t3_post2"}, checkpoint)
+}
+
+func TestStreamDedupCapacity(t *testing.T) {
+	require.Equal(t, defaultStreamDedupCapacity, newStreamConfig(nil).DedupCapacity)
+	require.Equal(t, defaultStreamDedupCapacity, newStreamConfig([]StreamOpt{StreamDedupCapacity(0)}).DedupCapacity)
+	require.Equal(t, 100, newStreamConfig([]StreamOpt{StreamDedupCapacity(10)}).DedupCapacity)
+	require.Equal(t, 500, newStreamConfig([]StreamOpt{StreamDedupCapacity(500)}).DedupCapacity)
+}
@@ -2,12 +2,37 @@ package reddit
 
 import "time"
 
-const defaultStreamInterval = time.Second * 5
+const (
+	defaultStreamInterval      = time.Second * 5
+	defaultStreamDedupCapacity = 1000
+	// Streams fetch up to 100 items at a time, which all need to be remembered.
+	minStreamDedupCapacity = 100
+)
 
 type streamConfig struct {
 	Interval       time.Duration
 	DiscardInitial bool
 	MaxRequests    int
+	DedupCapacity  int
+	MarkRead       bool
+	Checkpointer   Checkpointer
+	CheckpointName string
+
+	// Set by streams whose listings aren't sorted by the time the items appeared.
+	Unordered bool
+}
+
+func newStreamConfig(opts []StreamOpt) *streamConfig {
+	streamConfig := &streamConfig{
+		Interval:       defaultStreamInterval,
+		DiscardInitial: false,
+		MaxRequests:    0,
+		DedupCapacity:  defaultStreamDedupCapacity,
+	}
+	for _, opt := range opts {
+		opt(streamConfig)
+	}
+	return streamConfig
 }
 
 // StreamOpt is a configuration option to configure a stream.
@@ -38,6 +63,43 @@ func StreamMaxRequests(v int) StreamOpt {
 	}
 }
 
+// StreamDedupCapacity sets the number of recently streamed IDs that are remembered, to avoid
+// streaming items more than once. It can't be less than the number of items fetched at a time (100),
+// so smaller values are raised to 100. If less than or equal to 0, the default of 1000 will be used.
+func StreamDedupCapacity(v int) StreamOpt {
+	return func(c *streamConfig) {
+		if v > 0 {
+			if v < minStreamDedupCapacity {
+				v = minStreamDedupCapacity
+			}
+			c.DedupCapacity = v
+		}
+	}
+}
+
+// StreamMarkRead marks the items of inbox streams as read once they've been received.
+// It has no effect on other streams.
+func StreamMarkRead(c *streamConfig) {
+	c.MarkRead = true
+}
+
+// StreamCheckpoint makes the stream record the full ID and creation time of the newest item it has
+// streamed with the checkpointer, once all the items fetched with it have been received, and resume
+// from it when it's started again. On start, the items that appeared since the checkpoint are streamed
+// first, going back as far as Reddit's listing limit of 1000 items, and StreamDiscardInitial is ignored.
+// If the item of the checkpoint was removed in the meantime, the ones created after it are streamed.
+// If the name is empty, the stream's checkpoint is named after the path of its listing, e.g. "r/golang/new".
+// If the checkpointer is nil, it will not be set. It has no effect on the ModQueue, Reports, Spam,
+// Edited and Unmoderated streams, whose listings aren't sorted by the time the items appeared.
+func StreamCheckpoint(checkpointer Checkpointer, name string) StreamOpt {
+	return func(c *streamConfig) {
+		if checkpointer != nil {
+			c.Checkpointer = checkpointer
+			c.CheckpointName = name
+		}
+	}
+}
+
 // Streamer streams data to the client.
 // type Streamer interface {
 // 	Stream() (<-chan *rootListing, <-chan error, func())
@@ -0,0 +1,177 @@
+package reddit
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// TokenStore persists access tokens, so that they can be reused by other clients
+// or processes instead of requesting a new one from Reddit every time.
+type TokenStore interface {
+	// LoadToken returns the stored token, or nil if there isn't one.
+	LoadToken() (*oauth2.Token, error)
+	// SaveToken stores the token, replacing the existing one (if any).
+	SaveToken(token *oauth2.Token) error
+}
+
+// storedToken is the serialized form of a token.
+// The oauth2.Token type doesn't serialize its extra fields, but we need the scope.
+type storedToken struct {
+	AccessToken  string    `json:"access_token"`
+	TokenType    string    `json:"token_type,omitempty"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	Expiry       time.Time `json:"expiry,omitempty"`
+	Scope        string    `json:"scope,omitempty"`
+}
+
+func newStoredToken(token *oauth2.Token) *storedToken {
+	scope, _ := token.Extra("scope").(string)
+	return &storedToken{
+		AccessToken:  token.AccessToken,
+		TokenType:    token.TokenType,
+		RefreshToken: token.RefreshToken,
+		Expiry:       token.Expiry,
+		Scope:        scope,
+	}
+}
+
+func (t *storedToken) token() *oauth2.Token {
+	token := &oauth2.Token{
+		AccessToken:  t.AccessToken,
+		TokenType:    t.TokenType,
+		RefreshToken: t.RefreshToken,
+		Expiry:       t.Expiry,
+	}
+	return token.WithExtra(map[string]interface{}{"scope": t.Scope})
+}
+
+// MemoryTokenStore stores a token in memory.
+// It can be shared by multiple clients within the same process.
+type MemoryTokenStore struct {
+	mu    sync.Mutex
+	token *storedToken
+}
+
+// LoadToken returns the stored token, or nil if there isn't one.
+func (s *MemoryTokenStore) LoadToken() (*oauth2.Token, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token == nil {
+		return nil, nil
+	}
+	return s.token.token(), nil
+}
+
+// SaveToken stores the token, replacing the existing one (if any).
+func (s *MemoryTokenStore) SaveToken(token *oauth2.Token) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.token = newStoredToken(token)
+	return nil
+}
+
+// FileTokenStore stores a token as JSON in a file.
+// The file can be shared by multiple processes: it is replaced atomically on every save,
+// so a process never reads a partially written token. If 2 processes save at the
+// same time, the last one wins, which is fine since both tokens are valid.
+type FileTokenStore struct {
+	path string
+}
+
+// NewFileTokenStore returns a store that keeps the token in the file at path.
+// The file is created on the first save if it doesn't exist.
+func NewFileTokenStore(path string) *FileTokenStore {
+	return &FileTokenStore{path: path}
+}
+
+// LoadToken returns the stored token, or nil if the file doesn't exist.
+func (s *FileTokenStore) LoadToken() (*oauth2.Token, error) {
+	data, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	t := new(storedToken)
+	if err := json.Unmarshal(data, t); err != nil {
+		return nil, err
+	}
+
+	return t.token(), nil
+}
+
+// SaveToken stores the token, replacing the existing one (if any).
+// The token is first written to a temporary file in the same directory,
+// which is then renamed to the store's path.
+func (s *FileTokenStore) SaveToken(token *oauth2.Token) error {
+	data, err := json.Marshal(newStoredToken(token))
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(s.path, data)
+}
+
+// writeFileAtomic replaces the contents of the file at path with data. The data is first
+// written to a temporary file in the same directory, which is then renamed to path.
+func writeFileAtomic(path string, data []byte) error {
+	file, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	// no-op if the rename succeeded
+	defer os.Remove(file.Name())
+
+	if _, err := file.Write(data); err != nil {
+		file.Close()
+		return err
+	}
+
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), path)
+}
+
+// storeTokenSource checks the store for a valid token before getting one from the underlying
+// token source, and saves the tokens it gets from it. It is meant to be wrapped in a
+// oauth2.ReuseTokenSource, so that the store is only checked once the current token expires.
+type storeTokenSource struct {
+	store TokenStore
+	base  oauth2.TokenSource
+}
+
+func (s *storeTokenSource) Token() (*oauth2.Token, error) {
+	// another client or process might have already gotten a new token
+	token, err := s.store.LoadToken()
+	if err == nil && token.Valid() {
+		return token, nil
+	}
+
+	token, err = s.base.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	// the token is still usable if it couldn't be saved, so there's no
+	// point in failing the request; we'll just try again next time
+	_ = s.store.SaveToken(token)
+
+	return token, nil
+}
@@ -0,0 +1,146 @@
+package reddit
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+var testToken = (&oauth2.Token{
+	AccessToken:  "token1",
+	TokenType:    "bearer",
+	RefreshToken: "refresh1",
+	Expiry:       time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC),
+}).WithExtra(map[string]interface{}{"scope": "identity read"})
+
+func TestMemoryTokenStore(t *testing.T) {
+	store := new(MemoryTokenStore)
+
+	token, err := store.LoadToken()
+	require.NoError(t, err)
+	require.Nil(t, token)
+
+	err = store.SaveToken(testToken)
+	require.NoError(t, err)
+
+	token, err = store.LoadToken()
+	require.NoError(t, err)
+	require.Equal(t, "token1", token.AccessToken)
+	require.Equal(t, "refresh1", token.RefreshToken)
+	require.Equal(t, testToken.Expiry, token.Expiry)
+	require.Equal(t, "identity read", token.Extra("scope"))
+}
+
+func TestFileTokenStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "go-reddit")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "token.json")
+	store := NewFileTokenStore(path)
+
+	token, err := store.LoadToken()
+	require.NoError(t, err)
+	require.Nil(t, token)
+
+	err = store.SaveToken(testToken)
+	require.NoError(t, err)
+
+	// another store pointing to the same file, as if it were in another process
+	token, err = NewFileTokenStore(path).LoadToken()
+	require.NoError(t, err)
+	require.Equal(t, "token1", token.AccessToken)
+	require.Equal(t, "refresh1", token.RefreshToken)
+	require.True(t, testToken.Expiry.Equal(token.Expiry))
+	require.Equal(t, "identity read", token.Extra("scope"))
+
+	// no temporary files should be left behind
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+}
+
+func TestFileTokenStore_InvalidFile(t *testing.T) {
+	file, err := ioutil.TempFile("", "go-reddit")
+	require.NoError(t, err)
+	defer os.Remove(file.Name())
+
+	_, err = file.WriteString("not json")
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	_, err = NewFileTokenStore(file.Name()).LoadToken()
+	require.Error(t, err)
+}
+
+func TestClient_WithTokenStore(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var tokenRequests int
+	mux.HandleFunc("/api/v1/access_token", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		tokenRequests++
+
+		w.Header().Add(headerContentType, mediaTypeJSON)
+		fmt.Fprint(w, `{
+			"access_token": "token1",
+			"token_type": "bearer",
+			"expires_in": 3600,
+			"scope": "*"
+		}`)
+	})
+
+	mux.HandleFunc("/api/v1/me", func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodGet, r.Method)
+		require.Equal(t, "Bearer token1", r.Header.Get("Authorization"))
+		fmt.Fprint(w, `{"id": "user1id", "name": "user1"}`)
+	})
+
+	store := new(MemoryTokenStore)
+	newClient := func() *Client {
+		client, err := NewClient(nil,
+			&Credentials{"id1", "secret1", "user1", "password1"},
+			WithBaseURL(server.URL),
+			WithTokenURL(server.URL+"/api/v1/access_token"),
+			WithTokenStore(store),
+		)
+		require.NoError(t, err)
+		return client
+	}
+
+	_, _, err := newClient().Account.Info(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, tokenRequests)
+
+	token, err := store.LoadToken()
+	require.NoError(t, err)
+	require.Equal(t, "token1", token.AccessToken)
+	require.Equal(t, "*", token.Extra("scope"))
+
+	// the 2nd client should reuse the stored token
+	_, _, err = newClient().Account.Info(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, tokenRequests)
+
+	// once it expires, a new one is requested and stored
+	token.Expiry = time.Now().Add(-time.Minute)
+	require.NoError(t, store.SaveToken(token))
+
+	_, _, err = newClient().Account.Info(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, tokenRequests)
+
+	token, err = store.LoadToken()
+	require.NoError(t, err)
+	require.True(t, token.Valid())
+}
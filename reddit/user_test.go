@@ -850,7 +850,7 @@ func TestUserService_Unblock(t *testing.T) {
 		form := url.Values{}
 		form.Set("name", "test123")
 		form.Set("type", "enemy")
-		form.Set("container", client.redditID)
+		form.Set("container", "t2_self123")
 
 		err := r.ParseForm()
 		require.NoError(t, err)
@@ -873,7 +873,7 @@ func TestUserService_UnblockByID(t *testing.T) {
 		form := url.Values{}
 		form.Set("id", "abc123")
 		form.Set("type", "enemy")
-		form.Set("container", client.redditID)
+		form.Set("container", "t2_self123")
 
 		err := r.ParseForm()
 		require.NoError(t, err)